@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var EmptyIdError = errors.New("id is empty")
 
@@ -9,3 +12,22 @@ var NilArgumentError = errors.New("argument is nil")
 var NoTransactionError = errors.New("transaction is not opened")
 
 var BadRepeatedFieldMaskTpl = "unexpected fieldmask count %d for objects count %d"
+
+var ImmutableFieldMaskTpl = "field %s is immutable and can't be in the update mask"
+
+// VersionConflictError is returned by the update of a versioned type when its
+// row was changed by another writer since the version was read
+type VersionConflictError struct {
+	Type    string
+	Version int64
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s was changed since version %d", e.Type, e.Version)
+}
+
+// IsVersionConflict tells if err is or wraps a VersionConflictError
+func IsVersionConflict(err error) bool {
+	var conflict *VersionConflictError
+	return errors.As(err, &conflict)
+}
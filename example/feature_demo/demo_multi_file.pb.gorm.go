@@ -3,21 +3,23 @@ package example
 import (
 	context "context"
 	fmt "fmt"
-	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	gateway "github.com/kirinse/atlas-app-toolkit/gateway"
 	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
-	_go "github.com/satori/go.uuid"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	go_uuid "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
 )
 
 type ExternalChildORM struct {
 	Id                  string
-	PrimaryIncludedId   *_go.UUID
+	PrimaryIncludedId   *go_uuid.UUID
 	PrimaryStringTypeId *string
-	PrimaryUUIDTypeId   *_go.UUID
+	PrimaryUUIDTypeId   *go_uuid.UUID
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (ExternalChildORM) TableName() string {
 	return "external_children"
 }
@@ -59,22 +61,22 @@ func (m *ExternalChildORM) ToPB(ctx context.Context) (ExternalChild, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type ExternalChild the arg will be the target, the caller the one being converted from
 
-// ExternalChildBeforeToORM called before default ToORM code
+// ExternalChildWithBeforeToORM called before default ToORM code
 type ExternalChildWithBeforeToORM interface {
 	BeforeToORM(context.Context, *ExternalChildORM) error
 }
 
-// ExternalChildAfterToORM called after default ToORM code
+// ExternalChildWithAfterToORM called after default ToORM code
 type ExternalChildWithAfterToORM interface {
 	AfterToORM(context.Context, *ExternalChildORM) error
 }
 
-// ExternalChildBeforeToPB called before default ToPB code
+// ExternalChildWithBeforeToPB called before default ToPB code
 type ExternalChildWithBeforeToPB interface {
 	BeforeToPB(context.Context, *ExternalChild) error
 }
 
-// ExternalChildAfterToPB called after default ToPB code
+// ExternalChildWithAfterToPB called after default ToPB code
 type ExternalChildWithAfterToPB interface {
 	AfterToPB(context.Context, *ExternalChild) error
 }
@@ -85,7 +87,7 @@ type BlogPostORM struct {
 	Title  string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (BlogPostORM) TableName() string {
 	return "blog_posts"
 }
@@ -131,22 +133,22 @@ func (m *BlogPostORM) ToPB(ctx context.Context) (BlogPost, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type BlogPost the arg will be the target, the caller the one being converted from
 
-// BlogPostBeforeToORM called before default ToORM code
+// BlogPostWithBeforeToORM called before default ToORM code
 type BlogPostWithBeforeToORM interface {
 	BeforeToORM(context.Context, *BlogPostORM) error
 }
 
-// BlogPostAfterToORM called after default ToORM code
+// BlogPostWithAfterToORM called after default ToORM code
 type BlogPostWithAfterToORM interface {
 	AfterToORM(context.Context, *BlogPostORM) error
 }
 
-// BlogPostBeforeToPB called before default ToPB code
+// BlogPostWithBeforeToPB called before default ToPB code
 type BlogPostWithBeforeToPB interface {
 	BeforeToPB(context.Context, *BlogPost) error
 }
 
-// BlogPostAfterToPB called after default ToPB code
+// BlogPostWithAfterToPB called after default ToPB code
 type BlogPostWithAfterToPB interface {
 	AfterToPB(context.Context, *BlogPost) error
 }
@@ -210,7 +212,7 @@ func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.D
 		}
 	}
 	ormResponse := ExternalChildORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&ExternalChildORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(ExternalChildORMWithAfterReadFind); ok {
@@ -248,7 +250,7 @@ func DefaultDeleteExternalChild(ctx context.Context, in *ExternalChild, db *gorm
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&ExternalChildORM{}).Error
+	err = db.Where(&ExternalChildORM{Id: ormObj.Id}).Delete(&ExternalChildORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -306,14 +308,15 @@ type ExternalChildORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateExternalChild clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExternalChild")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateExternalChild")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &ExternalChildORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -336,6 +339,9 @@ func DefaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -361,7 +367,7 @@ func DefaultPatchExternalChild(ctx context.Context, in *ExternalChild, updateMas
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadExternalChild(ctx, &ExternalChild{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadExternalChild(ctx, &ExternalChild{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -464,7 +470,6 @@ func DefaultListExternalChild(ctx context.Context, db *gorm.DB) ([]*ExternalChil
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []ExternalChildORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -555,7 +560,7 @@ func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogP
 		}
 	}
 	ormResponse := BlogPostORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&BlogPostORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(BlogPostORMWithAfterReadFind); ok {
@@ -593,7 +598,7 @@ func DefaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) error
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&BlogPostORM{}).Error
+	err = db.Where(&BlogPostORM{Id: ormObj.Id}).Delete(&BlogPostORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -651,14 +656,15 @@ type BlogPostORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateBlogPost clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBlogPost")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateBlogPost")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &BlogPostORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -681,6 +687,9 @@ func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB)
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -706,7 +715,7 @@ func DefaultPatchBlogPost(ctx context.Context, in *BlogPost, updateMask *field_m
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadBlogPost(ctx, &BlogPost{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadBlogPost(ctx, &BlogPost{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -817,7 +826,6 @@ func DefaultListBlogPost(ctx context.Context, db *gorm.DB) ([]*BlogPost, error)
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []BlogPostORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
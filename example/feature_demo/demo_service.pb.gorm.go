@@ -4,13 +4,15 @@ import (
 	context "context"
 	json "encoding/json"
 	fmt "fmt"
-	errors "github.com/kirinse/protoc-gen-gorm/errors"
-	empty "github.com/golang/protobuf/ptypes/empty"
+	gateway "github.com/kirinse/atlas-app-toolkit/gateway"
 	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
 	query "github.com/kirinse/atlas-app-toolkit/query"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
 	trace "go.opencensus.io/trace"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
 )
 
 type IntPointORM struct {
@@ -19,7 +21,7 @@ type IntPointORM struct {
 	Y  int32
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (IntPointORM) TableName() string {
 	return "int_points"
 }
@@ -65,22 +67,22 @@ func (m *IntPointORM) ToPB(ctx context.Context) (IntPoint, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type IntPoint the arg will be the target, the caller the one being converted from
 
-// IntPointBeforeToORM called before default ToORM code
+// IntPointWithBeforeToORM called before default ToORM code
 type IntPointWithBeforeToORM interface {
 	BeforeToORM(context.Context, *IntPointORM) error
 }
 
-// IntPointAfterToORM called after default ToORM code
+// IntPointWithAfterToORM called after default ToORM code
 type IntPointWithAfterToORM interface {
 	AfterToORM(context.Context, *IntPointORM) error
 }
 
-// IntPointBeforeToPB called before default ToPB code
+// IntPointWithBeforeToPB called before default ToPB code
 type IntPointWithBeforeToPB interface {
 	BeforeToPB(context.Context, *IntPoint) error
 }
 
-// IntPointAfterToPB called after default ToPB code
+// IntPointWithAfterToPB called after default ToPB code
 type IntPointWithAfterToPB interface {
 	AfterToPB(context.Context, *IntPoint) error
 }
@@ -89,7 +91,7 @@ type SomethingORM struct {
 	Field string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (SomethingORM) TableName() string {
 	return "somethings"
 }
@@ -131,22 +133,22 @@ func (m *SomethingORM) ToPB(ctx context.Context) (Something, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Something the arg will be the target, the caller the one being converted from
 
-// SomethingBeforeToORM called before default ToORM code
+// SomethingWithBeforeToORM called before default ToORM code
 type SomethingWithBeforeToORM interface {
 	BeforeToORM(context.Context, *SomethingORM) error
 }
 
-// SomethingAfterToORM called after default ToORM code
+// SomethingWithAfterToORM called after default ToORM code
 type SomethingWithAfterToORM interface {
 	AfterToORM(context.Context, *SomethingORM) error
 }
 
-// SomethingBeforeToPB called before default ToPB code
+// SomethingWithBeforeToPB called before default ToPB code
 type SomethingWithBeforeToPB interface {
 	BeforeToPB(context.Context, *Something) error
 }
 
-// SomethingAfterToPB called after default ToPB code
+// SomethingWithAfterToPB called after default ToPB code
 type SomethingWithAfterToPB interface {
 	AfterToPB(context.Context, *Something) error
 }
@@ -155,7 +157,7 @@ type CircleORM struct {
 	R uint32
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (CircleORM) TableName() string {
 	return "circles"
 }
@@ -197,22 +199,22 @@ func (m *CircleORM) ToPB(ctx context.Context) (Circle, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Circle the arg will be the target, the caller the one being converted from
 
-// CircleBeforeToORM called before default ToORM code
+// CircleWithBeforeToORM called before default ToORM code
 type CircleWithBeforeToORM interface {
 	BeforeToORM(context.Context, *CircleORM) error
 }
 
-// CircleAfterToORM called after default ToORM code
+// CircleWithAfterToORM called after default ToORM code
 type CircleWithAfterToORM interface {
 	AfterToORM(context.Context, *CircleORM) error
 }
 
-// CircleBeforeToPB called before default ToPB code
+// CircleWithBeforeToPB called before default ToPB code
 type CircleWithBeforeToPB interface {
 	BeforeToPB(context.Context, *Circle) error
 }
 
-// CircleAfterToPB called after default ToPB code
+// CircleWithAfterToPB called after default ToPB code
 type CircleWithAfterToPB interface {
 	AfterToPB(context.Context, *Circle) error
 }
@@ -276,7 +278,7 @@ func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *que
 		}
 	}
 	ormResponse := IntPointORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&IntPointORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(IntPointORMWithAfterReadFind); ok {
@@ -314,7 +316,7 @@ func DefaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) error
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&IntPointORM{}).Error
+	err = db.Where(&IntPointORM{Id: ormObj.Id}).Delete(&IntPointORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -372,14 +374,15 @@ type IntPointORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateIntPoint clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateIntPoint")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateIntPoint")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &IntPointORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -402,6 +405,9 @@ func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB)
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -427,7 +433,7 @@ func DefaultPatchIntPoint(ctx context.Context, in *IntPoint, updateMask *field_m
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, nil)
+	pbReadRes, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.Id}, db, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +544,6 @@ func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []IntPointORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -644,7 +649,6 @@ func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	ormResponse := []SomethingORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -749,7 +753,6 @@ func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	ormResponse := []CircleORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -797,6 +800,10 @@ func (m *IntPointServiceDefaultServer) Create(ctx context.Context, in *CreateInt
 		return nil, err
 	}
 	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, err
+	}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreate); ok {
 		var err error
 		if err = custom.AfterCreate(ctx, out, db); err != nil {
@@ -811,7 +818,7 @@ type IntPointServiceIntPointWithBeforeCreate interface {
 	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
+// IntPointServiceIntPointWithAfterCreate called after DefaultCreateIntPoint in the default Create handler
 type IntPointServiceIntPointWithAfterCreate interface {
 	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
@@ -844,7 +851,7 @@ type IntPointServiceIntPointWithBeforeRead interface {
 	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterRead called before DefaultReadIntPoint in the default Read handler
+// IntPointServiceIntPointWithAfterRead called after DefaultReadIntPoint in the default Read handler
 type IntPointServiceIntPointWithAfterRead interface {
 	AfterRead(context.Context, *ReadIntPointResponse, *gorm.DB) error
 }
@@ -878,12 +885,12 @@ func (m *IntPointServiceDefaultServer) Update(ctx context.Context, in *UpdateInt
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeUpdate called before DefaultUpdateIntPoint in the default Update handler
+// IntPointServiceIntPointWithBeforeUpdate called before DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default Update handler
 type IntPointServiceIntPointWithBeforeUpdate interface {
 	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterUpdate called before DefaultUpdateIntPoint in the default Update handler
+// IntPointServiceIntPointWithAfterUpdate called after DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default Update handler
 type IntPointServiceIntPointWithAfterUpdate interface {
 	AfterUpdate(context.Context, *UpdateIntPointResponse, *gorm.DB) error
 }
@@ -920,12 +927,12 @@ func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *Update
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeUpdateSet called before DefaultUpdateSetIntPoint in the default UpdateSet handler
+// IntPointServiceIntPointWithBeforeUpdateSet called before DefaultPatchSetIntPoint in the default UpdateSet handler
 type IntPointServiceIntPointWithBeforeUpdateSet interface {
 	BeforeUpdateSet(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterUpdateSet called before DefaultUpdateSetIntPoint in the default UpdateSet handler
+// IntPointServiceIntPointWithAfterUpdateSet called after DefaultPatchSetIntPoint in the default UpdateSet handler
 type IntPointServiceIntPointWithAfterUpdateSet interface {
 	AfterUpdateSet(context.Context, *UpdateSetIntPointResponse, *gorm.DB) error
 }
@@ -974,13 +981,13 @@ type IntPointServiceIntPointWithBeforeList interface {
 	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterList called before DefaultListIntPoint in the default List handler
+// IntPointServiceIntPointWithAfterList called after DefaultListIntPoint in the default List handler
 type IntPointServiceIntPointWithAfterList interface {
 	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
 }
 
 // ListSomething ...
-func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *empty.Empty) (*ListSomethingResponse, error) {
+func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *emptypb.Empty) (*ListSomethingResponse, error) {
 	db := m.DB
 	if custom, ok := interface{}(in).(IntPointServiceSomethingWithBeforeListSomething); ok {
 		var err error
@@ -1002,12 +1009,12 @@ func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *em
 	return out, nil
 }
 
-// IntPointServiceSomethingWithBeforeListSomething called before DefaultListSomethingSomething in the default ListSomething handler
+// IntPointServiceSomethingWithBeforeListSomething called before DefaultListSomething in the default ListSomething handler
 type IntPointServiceSomethingWithBeforeListSomething interface {
 	BeforeListSomething(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceSomethingWithAfterListSomething called before DefaultListSomethingSomething in the default ListSomething handler
+// IntPointServiceSomethingWithAfterListSomething called after DefaultListSomething in the default ListSomething handler
 type IntPointServiceSomethingWithAfterListSomething interface {
 	AfterListSomething(context.Context, *ListSomethingResponse, *gorm.DB) error
 }
@@ -1040,14 +1047,14 @@ type IntPointServiceIntPointWithBeforeDelete interface {
 	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterDelete called before DefaultDeleteIntPoint in the default Delete handler
+// IntPointServiceIntPointWithAfterDelete called after DefaultDeleteIntPoint in the default Delete handler
 type IntPointServiceIntPointWithAfterDelete interface {
 	AfterDelete(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
 
 // CustomMethod ...
-func (m *IntPointServiceDefaultServer) CustomMethod(ctx context.Context, in *empty.Empty) (*empty.Empty, error) {
-	out := &empty.Empty{}
+func (m *IntPointServiceDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	out := &emptypb.Empty{}
 	return out, nil
 }
 
@@ -1116,6 +1123,10 @@ func (m *IntPointTxnDefaultServer) Create(ctx context.Context, in *CreateIntPoin
 		return nil, m.spanError(span, err)
 	}
 	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, m.spanError(span, err)
+	}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterCreate); ok {
 		var err error
 		if err = custom.AfterCreate(ctx, out, db); err != nil {
@@ -1134,7 +1145,7 @@ type IntPointTxnIntPointWithBeforeCreate interface {
 	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
+// IntPointTxnIntPointWithAfterCreate called after DefaultCreateIntPoint in the default Create handler
 type IntPointTxnIntPointWithAfterCreate interface {
 	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
@@ -1183,7 +1194,7 @@ type IntPointTxnIntPointWithBeforeRead interface {
 	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterRead called before DefaultReadIntPoint in the default Read handler
+// IntPointTxnIntPointWithAfterRead called after DefaultReadIntPoint in the default Read handler
 type IntPointTxnIntPointWithAfterRead interface {
 	AfterRead(context.Context, *ReadIntPointResponse, *gorm.DB) error
 }
@@ -1233,12 +1244,12 @@ func (m *IntPointTxnDefaultServer) Update(ctx context.Context, in *UpdateIntPoin
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeUpdate called before DefaultUpdateIntPoint in the default Update handler
+// IntPointTxnIntPointWithBeforeUpdate called before DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default Update handler
 type IntPointTxnIntPointWithBeforeUpdate interface {
 	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterUpdate called before DefaultUpdateIntPoint in the default Update handler
+// IntPointTxnIntPointWithAfterUpdate called after DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default Update handler
 type IntPointTxnIntPointWithAfterUpdate interface {
 	AfterUpdate(context.Context, *UpdateIntPointResponse, *gorm.DB) error
 }
@@ -1303,7 +1314,7 @@ type IntPointTxnIntPointWithBeforeList interface {
 	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterList called before DefaultListIntPoint in the default List handler
+// IntPointTxnIntPointWithAfterList called after DefaultListIntPoint in the default List handler
 type IntPointTxnIntPointWithAfterList interface {
 	AfterList(context.Context, *ListIntPointResponse, *gorm.DB) error
 }
@@ -1352,7 +1363,7 @@ type IntPointTxnIntPointWithBeforeDelete interface {
 	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterDelete called before DefaultDeleteIntPoint in the default Delete handler
+// IntPointTxnIntPointWithAfterDelete called after DefaultDeleteIntPoint in the default Delete handler
 type IntPointTxnIntPointWithAfterDelete interface {
 	AfterDelete(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
@@ -1400,24 +1411,24 @@ func (m *IntPointTxnDefaultServer) DeleteSet(ctx context.Context, in *DeleteIntP
 	return out, nil
 }
 
-// IntPointTxnIntPointWithBeforeDeleteSet called before DefaultDeleteSetIntPoint in the default DeleteSet handler
+// IntPointTxnIntPointWithBeforeDeleteSet called before DefaultDeleteIntPointSet in the default DeleteSet handler
 type IntPointTxnIntPointWithBeforeDeleteSet interface {
 	BeforeDeleteSet(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointTxnIntPointWithAfterDeleteSet called before DefaultDeleteSetIntPoint in the default DeleteSet handler
+// IntPointTxnIntPointWithAfterDeleteSet called after DefaultDeleteIntPointSet in the default DeleteSet handler
 type IntPointTxnIntPointWithAfterDeleteSet interface {
 	AfterDeleteSet(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
 
 // CustomMethod ...
-func (m *IntPointTxnDefaultServer) CustomMethod(ctx context.Context, in *empty.Empty) (*empty.Empty, error) {
+func (m *IntPointTxnDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
 	span, errSpanCreate := m.spanCreate(ctx, in, "CustomMethod")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
 	}
 	defer span.End()
-	out := &empty.Empty{}
+	out := &emptypb.Empty{}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
 		return nil, m.spanError(span, errSpanResult)
@@ -1472,7 +1483,7 @@ type CircleServiceCircleWithBeforeList interface {
 	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// CircleServiceCircleWithAfterList called before DefaultListCircle in the default List handler
+// CircleServiceCircleWithAfterList called after DefaultListCircle in the default List handler
 type CircleServiceCircleWithAfterList interface {
 	AfterList(context.Context, *ListCircleResponse, *gorm.DB) error
 }
@@ -1494,6 +1505,10 @@ func (m *MultipleMethodsAutoGenDefaultServer) CreateA(ctx context.Context, in *C
 		return nil, err
 	}
 	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, err
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateA); ok {
 		var err error
 		if err = custom.AfterCreateA(ctx, out, db); err != nil {
@@ -1503,12 +1518,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) CreateA(ctx context.Context, in *C
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeCreateA called before DefaultCreateAIntPoint in the default CreateA handler
+// MultipleMethodsAutoGenIntPointWithBeforeCreateA called before DefaultCreateIntPoint in the default CreateA handler
 type MultipleMethodsAutoGenIntPointWithBeforeCreateA interface {
 	BeforeCreateA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterCreateA called before DefaultCreateAIntPoint in the default CreateA handler
+// MultipleMethodsAutoGenIntPointWithAfterCreateA called after DefaultCreateIntPoint in the default CreateA handler
 type MultipleMethodsAutoGenIntPointWithAfterCreateA interface {
 	AfterCreateA(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
@@ -1527,6 +1542,10 @@ func (m *MultipleMethodsAutoGenDefaultServer) CreateB(ctx context.Context, in *C
 		return nil, err
 	}
 	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, err
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateB); ok {
 		var err error
 		if err = custom.AfterCreateB(ctx, out, db); err != nil {
@@ -1536,12 +1555,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) CreateB(ctx context.Context, in *C
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeCreateB called before DefaultCreateBIntPoint in the default CreateB handler
+// MultipleMethodsAutoGenIntPointWithBeforeCreateB called before DefaultCreateIntPoint in the default CreateB handler
 type MultipleMethodsAutoGenIntPointWithBeforeCreateB interface {
 	BeforeCreateB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterCreateB called before DefaultCreateBIntPoint in the default CreateB handler
+// MultipleMethodsAutoGenIntPointWithAfterCreateB called after DefaultCreateIntPoint in the default CreateB handler
 type MultipleMethodsAutoGenIntPointWithAfterCreateB interface {
 	AfterCreateB(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
@@ -1569,12 +1588,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) ReadA(ctx context.Context, in *Rea
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeReadA called before DefaultReadAIntPoint in the default ReadA handler
+// MultipleMethodsAutoGenIntPointWithBeforeReadA called before DefaultReadIntPoint in the default ReadA handler
 type MultipleMethodsAutoGenIntPointWithBeforeReadA interface {
 	BeforeReadA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterReadA called before DefaultReadAIntPoint in the default ReadA handler
+// MultipleMethodsAutoGenIntPointWithAfterReadA called after DefaultReadIntPoint in the default ReadA handler
 type MultipleMethodsAutoGenIntPointWithAfterReadA interface {
 	AfterReadA(context.Context, *ReadIntPointResponse, *gorm.DB) error
 }
@@ -1602,12 +1621,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) ReadB(ctx context.Context, in *Rea
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeReadB called before DefaultReadBIntPoint in the default ReadB handler
+// MultipleMethodsAutoGenIntPointWithBeforeReadB called before DefaultReadIntPoint in the default ReadB handler
 type MultipleMethodsAutoGenIntPointWithBeforeReadB interface {
 	BeforeReadB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterReadB called before DefaultReadBIntPoint in the default ReadB handler
+// MultipleMethodsAutoGenIntPointWithAfterReadB called after DefaultReadIntPoint in the default ReadB handler
 type MultipleMethodsAutoGenIntPointWithAfterReadB interface {
 	AfterReadB(context.Context, *ReadIntPointResponse, *gorm.DB) error
 }
@@ -1641,12 +1660,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) UpdateA(ctx context.Context, in *U
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeUpdateA called before DefaultUpdateAIntPoint in the default UpdateA handler
+// MultipleMethodsAutoGenIntPointWithBeforeUpdateA called before DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default UpdateA handler
 type MultipleMethodsAutoGenIntPointWithBeforeUpdateA interface {
 	BeforeUpdateA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterUpdateA called before DefaultUpdateAIntPoint in the default UpdateA handler
+// MultipleMethodsAutoGenIntPointWithAfterUpdateA called after DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default UpdateA handler
 type MultipleMethodsAutoGenIntPointWithAfterUpdateA interface {
 	AfterUpdateA(context.Context, *UpdateIntPointResponse, *gorm.DB) error
 }
@@ -1680,12 +1699,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) UpdateB(ctx context.Context, in *U
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeUpdateB called before DefaultUpdateBIntPoint in the default UpdateB handler
+// MultipleMethodsAutoGenIntPointWithBeforeUpdateB called before DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default UpdateB handler
 type MultipleMethodsAutoGenIntPointWithBeforeUpdateB interface {
 	BeforeUpdateB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterUpdateB called before DefaultUpdateBIntPoint in the default UpdateB handler
+// MultipleMethodsAutoGenIntPointWithAfterUpdateB called after DefaultStrictUpdateIntPoint or DefaultPatchIntPoint in the default UpdateB handler
 type MultipleMethodsAutoGenIntPointWithAfterUpdateB interface {
 	AfterUpdateB(context.Context, *UpdateIntPointResponse, *gorm.DB) error
 }
@@ -1729,12 +1748,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *Lis
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeListA called before DefaultListAIntPoint in the default ListA handler
+// MultipleMethodsAutoGenIntPointWithBeforeListA called before DefaultListIntPoint in the default ListA handler
 type MultipleMethodsAutoGenIntPointWithBeforeListA interface {
 	BeforeListA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterListA called before DefaultListAIntPoint in the default ListA handler
+// MultipleMethodsAutoGenIntPointWithAfterListA called after DefaultListIntPoint in the default ListA handler
 type MultipleMethodsAutoGenIntPointWithAfterListA interface {
 	AfterListA(context.Context, *ListIntPointResponse, *gorm.DB) error
 }
@@ -1778,12 +1797,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *Lis
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeListB called before DefaultListBIntPoint in the default ListB handler
+// MultipleMethodsAutoGenIntPointWithBeforeListB called before DefaultListIntPoint in the default ListB handler
 type MultipleMethodsAutoGenIntPointWithBeforeListB interface {
 	BeforeListB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterListB called before DefaultListBIntPoint in the default ListB handler
+// MultipleMethodsAutoGenIntPointWithAfterListB called after DefaultListIntPoint in the default ListB handler
 type MultipleMethodsAutoGenIntPointWithAfterListB interface {
 	AfterListB(context.Context, *ListIntPointResponse, *gorm.DB) error
 }
@@ -1811,12 +1830,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteA(ctx context.Context, in *D
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteA called before DefaultDeleteAIntPoint in the default DeleteA handler
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteA called before DefaultDeleteIntPoint in the default DeleteA handler
 type MultipleMethodsAutoGenIntPointWithBeforeDeleteA interface {
 	BeforeDeleteA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteA called before DefaultDeleteAIntPoint in the default DeleteA handler
+// MultipleMethodsAutoGenIntPointWithAfterDeleteA called after DefaultDeleteIntPoint in the default DeleteA handler
 type MultipleMethodsAutoGenIntPointWithAfterDeleteA interface {
 	AfterDeleteA(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
@@ -1844,12 +1863,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteB(ctx context.Context, in *D
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteB called before DefaultDeleteBIntPoint in the default DeleteB handler
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteB called before DefaultDeleteIntPoint in the default DeleteB handler
 type MultipleMethodsAutoGenIntPointWithBeforeDeleteB interface {
 	BeforeDeleteB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteB called before DefaultDeleteBIntPoint in the default DeleteB handler
+// MultipleMethodsAutoGenIntPointWithAfterDeleteB called after DefaultDeleteIntPoint in the default DeleteB handler
 type MultipleMethodsAutoGenIntPointWithAfterDeleteB interface {
 	AfterDeleteB(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
@@ -1881,12 +1900,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetA(ctx context.Context, in
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA called before DefaultDeleteSetAIntPoint in the default DeleteSetA handler
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA called before DefaultDeleteIntPointSet in the default DeleteSetA handler
 type MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA interface {
 	BeforeDeleteSetA(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteSetA called before DefaultDeleteSetAIntPoint in the default DeleteSetA handler
+// MultipleMethodsAutoGenIntPointWithAfterDeleteSetA called after DefaultDeleteIntPointSet in the default DeleteSetA handler
 type MultipleMethodsAutoGenIntPointWithAfterDeleteSetA interface {
 	AfterDeleteSetA(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
@@ -1918,12 +1937,12 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetB(ctx context.Context, in
 	return out, nil
 }
 
-// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB called before DefaultDeleteSetBIntPoint in the default DeleteSetB handler
+// MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB called before DefaultDeleteIntPointSet in the default DeleteSetB handler
 type MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB interface {
 	BeforeDeleteSetB(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// MultipleMethodsAutoGenIntPointWithAfterDeleteSetB called before DefaultDeleteSetBIntPoint in the default DeleteSetB handler
+// MultipleMethodsAutoGenIntPointWithAfterDeleteSetB called after DefaultDeleteIntPointSet in the default DeleteSetB handler
 type MultipleMethodsAutoGenIntPointWithAfterDeleteSetB interface {
 	AfterDeleteSetB(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
@@ -3,19 +3,21 @@ package example
 import (
 	context "context"
 	fmt "fmt"
-	ptypes "github.com/golang/protobuf/ptypes"
-	empty "github.com/golang/protobuf/ptypes/empty"
-	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	auth "github.com/kirinse/atlas-app-toolkit/auth"
+	gateway "github.com/kirinse/atlas-app-toolkit/gateway"
 	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
 	errors "github.com/kirinse/protoc-gen-gorm/errors"
 	user "github.com/kirinse/protoc-gen-gorm/example/user"
 	types "github.com/kirinse/protoc-gen-gorm/types"
 	pq "github.com/lib/pq"
-	_go "github.com/satori/go.uuid"
+	go_uuid "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	datatypes "gorm.io/datatypes"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
 	strings "strings"
 	time "time"
 )
@@ -27,16 +29,16 @@ type TestTypesORM struct {
 	BecomesInt                string
 	CreatedAt                 *time.Time
 	JsonField                 datatypes.JSON
-	NullableUuid              *_go.UUID     `gorm:"type:uuid"`
+	NullableUuid              *go_uuid.UUID
 	Numbers                   pq.Int32Array `gorm:"type:integer[]"`
 	OptionalString            *string
 	ThingsTypeWithIDId        *uint32
 	TimeOnly                  string `gorm:"type:time"`
 	TypeWithIdId              uint32
-	Uuid                      _go.UUID `gorm:"type:uuid"`
+	Uuid                      go_uuid.UUID
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TestTypesORM) TableName() string {
 	return "smorgasbord"
 }
@@ -61,18 +63,18 @@ func (m *TestTypes) ToORM(ctx context.Context) (TestTypesORM, error) {
 	}
 	to.BecomesInt = TestTypesStatus_name[int32(m.BecomesInt)]
 	if m.Uuid != nil {
-		to.Uuid, err = _go.FromString(m.Uuid.Value)
+		to.Uuid, err = go_uuid.FromString(m.Uuid.Value)
 		if err != nil {
 			return to, err
 		}
 	} else {
-		to.Uuid = _go.Nil
+		to.Uuid = go_uuid.Nil
 	}
 	if m.CreatedAt != nil {
-		var t time.Time
-		if t, err = ptypes.Timestamp(m.CreatedAt); err != nil {
-			return to, err
+		if !m.CreatedAt.IsValid() {
+			return to, fmt.Errorf("CreatedAt invalid")
 		}
+		t := m.CreatedAt.AsTime()
 		to.CreatedAt = &t
 	}
 	to.TypeWithIdId = m.TypeWithIdId
@@ -80,7 +82,7 @@ func (m *TestTypes) ToORM(ctx context.Context) (TestTypesORM, error) {
 		to.JsonField = datatypes.JSON([]byte(m.JsonField.Value))
 	}
 	if m.NullableUuid != nil {
-		tempUUID, uErr := _go.FromString(m.NullableUuid.Value)
+		tempUUID, uErr := go_uuid.FromString(m.NullableUuid.Value)
 		if uErr != nil {
 			return to, uErr
 		}
@@ -112,14 +114,16 @@ func (m *TestTypesORM) ToPB(ctx context.Context) (TestTypes, error) {
 		copy(to.Numbers, m.Numbers)
 	}
 	if m.OptionalString != nil {
-		to.OptionalString = &wrappers.StringValue{Value: *m.OptionalString}
+		to.OptionalString = &wrapperspb.StringValue{Value: *m.OptionalString}
+	}
+	tempBecomesInt, ok := TestTypesStatus_value[m.BecomesInt]
+	if !ok && m.BecomesInt != "" {
+		return to, fmt.Errorf("unknown TestTypesStatus value %q", m.BecomesInt)
 	}
-	to.BecomesInt = TestTypesStatus(TestTypesStatus_value[m.BecomesInt])
+	to.BecomesInt = TestTypesStatus(tempBecomesInt)
 	to.Uuid = &types.UUID{Value: m.Uuid.String()}
 	if m.CreatedAt != nil {
-		if to.CreatedAt, err = ptypes.TimestampProto(*m.CreatedAt); err != nil {
-			return to, err
-		}
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
 	}
 	to.TypeWithIdId = m.TypeWithIdId
 	if m.JsonField != nil {
@@ -142,28 +146,28 @@ func (m *TestTypesORM) ToPB(ctx context.Context) (TestTypes, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestTypes the arg will be the target, the caller the one being converted from
 
-// TestTypesBeforeToORM called before default ToORM code
+// TestTypesWithBeforeToORM called before default ToORM code
 type TestTypesWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TestTypesORM) error
 }
 
-// TestTypesAfterToORM called after default ToORM code
+// TestTypesWithAfterToORM called after default ToORM code
 type TestTypesWithAfterToORM interface {
 	AfterToORM(context.Context, *TestTypesORM) error
 }
 
-// TestTypesBeforeToPB called before default ToPB code
+// TestTypesWithBeforeToPB called before default ToPB code
 type TestTypesWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TestTypes) error
 }
 
-// TestTypesAfterToPB called after default ToPB code
+// TestTypesWithAfterToPB called after default ToPB code
 type TestTypesWithAfterToPB interface {
 	AfterToPB(context.Context, *TestTypes) error
 }
 
 type TypeWithIDORM struct {
-	ANestedObject     *TestTypesORM `gorm:"foreignkey:ANestedObjectTypeWithIDId;association_foreignkey:Id"`
+	ANestedObject     *TestTypesORM `gorm:"foreignKey:ANestedObjectTypeWithIDId;references:Id"`
 	Address           *types.Inet   `gorm:"type:inet"`
 	DeletedAt         *time.Time
 	DoubleField       *float64
@@ -171,18 +175,18 @@ type TypeWithIDORM struct {
 	Id                uint32
 	IntPointId        *uint32
 	Ip                string          `gorm:"column:ip_addr"`
-	MultiAccountTypes []*JoinTable    `gorm:"foreignkey:TypeWithIDID"`
-	Point             *IntPointORM    `gorm:"foreignkey:IntPointId;association_foreignkey:Id"`
+	MultiAccountTypes []*JoinTable    `gorm:"foreignKey:TypeWithIDID"`
+	Point             *IntPointORM    `gorm:"foreignKey:IntPointId;references:Id"`
 	SecretInt         int32           `gorm:"-"`
 	TagSizeTest       string          `gorm:"size:512"`
 	TagTest           float32         `gorm:"type:float;precision:6"`
-	Things            []*TestTypesORM `gorm:"foreignkey:ThingsTypeWithIDId;association_foreignkey:Id"`
+	Things            []*TestTypesORM `gorm:"foreignKey:ThingsTypeWithIDId;references:Id"`
 	TimeOnly          string          `gorm:"type:time"`
-	User              *user.UserORM   `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	User              *user.UserORM   `gorm:"foreignKey:UserId;references:Id"`
 	UserId            *string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TypeWithIDORM) TableName() string {
 	return "type_with_ids"
 }
@@ -252,10 +256,10 @@ func (m *TypeWithID) ToORM(ctx context.Context) (TypeWithIDORM, error) {
 		}
 	}
 	if m.DeletedAt != nil {
-		var t time.Time
-		if t, err = ptypes.Timestamp(m.DeletedAt); err != nil {
-			return to, err
+		if !m.DeletedAt.IsValid() {
+			return to, fmt.Errorf("DeletedAt invalid")
 		}
+		t := m.DeletedAt.AsTime()
 		to.DeletedAt = &t
 	}
 	if posthook, ok := interface{}(m).(TypeWithIDWithAfterToORM); ok {
@@ -314,10 +318,10 @@ func (m *TypeWithIDORM) ToPB(ctx context.Context) (TypeWithID, error) {
 	to.TagTest = m.TagTest
 	to.TagSizeTest = m.TagSizeTest
 	if m.FloatField != nil {
-		to.FloatField = &wrappers.FloatValue{Value: *m.FloatField}
+		to.FloatField = &wrapperspb.FloatValue{Value: *m.FloatField}
 	}
 	if m.DoubleField != nil {
-		to.DoubleField = &wrappers.DoubleValue{Value: *m.DoubleField}
+		to.DoubleField = &wrapperspb.DoubleValue{Value: *m.DoubleField}
 	}
 	if m.TimeOnly != "" {
 		if to.TimeOnly, err = types.TimeOnlyByString(m.TimeOnly); err != nil {
@@ -325,9 +329,7 @@ func (m *TypeWithIDORM) ToPB(ctx context.Context) (TypeWithID, error) {
 		}
 	}
 	if m.DeletedAt != nil {
-		if to.DeletedAt, err = ptypes.TimestampProto(*m.DeletedAt); err != nil {
-			return to, err
-		}
+		to.DeletedAt = timestamppb.New(*m.DeletedAt)
 	}
 	if posthook, ok := interface{}(m).(TypeWithIDWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
@@ -338,22 +340,22 @@ func (m *TypeWithIDORM) ToPB(ctx context.Context) (TypeWithID, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TypeWithID the arg will be the target, the caller the one being converted from
 
-// TypeWithIDBeforeToORM called before default ToORM code
+// TypeWithIDWithBeforeToORM called before default ToORM code
 type TypeWithIDWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TypeWithIDORM) error
 }
 
-// TypeWithIDAfterToORM called after default ToORM code
+// TypeWithIDWithAfterToORM called after default ToORM code
 type TypeWithIDWithAfterToORM interface {
 	AfterToORM(context.Context, *TypeWithIDORM) error
 }
 
-// TypeWithIDBeforeToPB called before default ToPB code
+// TypeWithIDWithBeforeToPB called before default ToPB code
 type TypeWithIDWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TypeWithID) error
 }
 
-// TypeWithIDAfterToPB called after default ToPB code
+// TypeWithIDWithAfterToPB called after default ToPB code
 type TypeWithIDWithAfterToPB interface {
 	AfterToPB(context.Context, *TypeWithID) error
 }
@@ -364,7 +366,7 @@ type MultiaccountTypeWithIDORM struct {
 	SomeField string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (MultiaccountTypeWithIDORM) TableName() string {
 	return "multiaccount_type_with_ids"
 }
@@ -413,22 +415,22 @@ func (m *MultiaccountTypeWithIDORM) ToPB(ctx context.Context) (MultiaccountTypeW
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type MultiaccountTypeWithID the arg will be the target, the caller the one being converted from
 
-// MultiaccountTypeWithIDBeforeToORM called before default ToORM code
+// MultiaccountTypeWithIDWithBeforeToORM called before default ToORM code
 type MultiaccountTypeWithIDWithBeforeToORM interface {
 	BeforeToORM(context.Context, *MultiaccountTypeWithIDORM) error
 }
 
-// MultiaccountTypeWithIDAfterToORM called after default ToORM code
+// MultiaccountTypeWithIDWithAfterToORM called after default ToORM code
 type MultiaccountTypeWithIDWithAfterToORM interface {
 	AfterToORM(context.Context, *MultiaccountTypeWithIDORM) error
 }
 
-// MultiaccountTypeWithIDBeforeToPB called before default ToPB code
+// MultiaccountTypeWithIDWithBeforeToPB called before default ToPB code
 type MultiaccountTypeWithIDWithBeforeToPB interface {
 	BeforeToPB(context.Context, *MultiaccountTypeWithID) error
 }
 
-// MultiaccountTypeWithIDAfterToPB called after default ToPB code
+// MultiaccountTypeWithIDWithAfterToPB called after default ToPB code
 type MultiaccountTypeWithIDWithAfterToPB interface {
 	AfterToPB(context.Context, *MultiaccountTypeWithID) error
 }
@@ -438,7 +440,7 @@ type MultiaccountTypeWithoutIDORM struct {
 	SomeField string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (MultiaccountTypeWithoutIDORM) TableName() string {
 	return "multiaccount_type_without_ids"
 }
@@ -485,32 +487,32 @@ func (m *MultiaccountTypeWithoutIDORM) ToPB(ctx context.Context) (MultiaccountTy
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type MultiaccountTypeWithoutID the arg will be the target, the caller the one being converted from
 
-// MultiaccountTypeWithoutIDBeforeToORM called before default ToORM code
+// MultiaccountTypeWithoutIDWithBeforeToORM called before default ToORM code
 type MultiaccountTypeWithoutIDWithBeforeToORM interface {
 	BeforeToORM(context.Context, *MultiaccountTypeWithoutIDORM) error
 }
 
-// MultiaccountTypeWithoutIDAfterToORM called after default ToORM code
+// MultiaccountTypeWithoutIDWithAfterToORM called after default ToORM code
 type MultiaccountTypeWithoutIDWithAfterToORM interface {
 	AfterToORM(context.Context, *MultiaccountTypeWithoutIDORM) error
 }
 
-// MultiaccountTypeWithoutIDBeforeToPB called before default ToPB code
+// MultiaccountTypeWithoutIDWithBeforeToPB called before default ToPB code
 type MultiaccountTypeWithoutIDWithBeforeToPB interface {
 	BeforeToPB(context.Context, *MultiaccountTypeWithoutID) error
 }
 
-// MultiaccountTypeWithoutIDAfterToPB called after default ToPB code
+// MultiaccountTypeWithoutIDWithAfterToPB called after default ToPB code
 type MultiaccountTypeWithoutIDWithAfterToPB interface {
 	AfterToPB(context.Context, *MultiaccountTypeWithoutID) error
 }
 
 type PrimaryUUIDTypeORM struct {
-	Child *ExternalChildORM `gorm:"foreignkey:PrimaryUUIDTypeId;association_foreignkey:Id"`
-	Id    *_go.UUID         `gorm:"type:uuid"`
+	Child *ExternalChildORM `gorm:"foreignKey:PrimaryUUIDTypeId;references:Id"`
+	Id    *go_uuid.UUID
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (PrimaryUUIDTypeORM) TableName() string {
 	return "primary_uuid_types"
 }
@@ -526,7 +528,7 @@ func (m *PrimaryUUIDType) ToORM(ctx context.Context) (PrimaryUUIDTypeORM, error)
 		}
 	}
 	if m.Id != nil {
-		tempUUID, uErr := _go.FromString(m.Id.Value)
+		tempUUID, uErr := go_uuid.FromString(m.Id.Value)
 		if uErr != nil {
 			return to, uErr
 		}
@@ -574,32 +576,32 @@ func (m *PrimaryUUIDTypeORM) ToPB(ctx context.Context) (PrimaryUUIDType, error)
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type PrimaryUUIDType the arg will be the target, the caller the one being converted from
 
-// PrimaryUUIDTypeBeforeToORM called before default ToORM code
+// PrimaryUUIDTypeWithBeforeToORM called before default ToORM code
 type PrimaryUUIDTypeWithBeforeToORM interface {
 	BeforeToORM(context.Context, *PrimaryUUIDTypeORM) error
 }
 
-// PrimaryUUIDTypeAfterToORM called after default ToORM code
+// PrimaryUUIDTypeWithAfterToORM called after default ToORM code
 type PrimaryUUIDTypeWithAfterToORM interface {
 	AfterToORM(context.Context, *PrimaryUUIDTypeORM) error
 }
 
-// PrimaryUUIDTypeBeforeToPB called before default ToPB code
+// PrimaryUUIDTypeWithBeforeToPB called before default ToPB code
 type PrimaryUUIDTypeWithBeforeToPB interface {
 	BeforeToPB(context.Context, *PrimaryUUIDType) error
 }
 
-// PrimaryUUIDTypeAfterToPB called after default ToPB code
+// PrimaryUUIDTypeWithAfterToPB called after default ToPB code
 type PrimaryUUIDTypeWithAfterToPB interface {
 	AfterToPB(context.Context, *PrimaryUUIDType) error
 }
 
 type PrimaryStringTypeORM struct {
-	Child *ExternalChildORM `gorm:"foreignkey:PrimaryStringTypeId;association_foreignkey:Id"`
+	Child *ExternalChildORM `gorm:"foreignKey:PrimaryStringTypeId;references:Id"`
 	Id    string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (PrimaryStringTypeORM) TableName() string {
 	return "primary_string_types"
 }
@@ -655,32 +657,32 @@ func (m *PrimaryStringTypeORM) ToPB(ctx context.Context) (PrimaryStringType, err
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type PrimaryStringType the arg will be the target, the caller the one being converted from
 
-// PrimaryStringTypeBeforeToORM called before default ToORM code
+// PrimaryStringTypeWithBeforeToORM called before default ToORM code
 type PrimaryStringTypeWithBeforeToORM interface {
 	BeforeToORM(context.Context, *PrimaryStringTypeORM) error
 }
 
-// PrimaryStringTypeAfterToORM called after default ToORM code
+// PrimaryStringTypeWithAfterToORM called after default ToORM code
 type PrimaryStringTypeWithAfterToORM interface {
 	AfterToORM(context.Context, *PrimaryStringTypeORM) error
 }
 
-// PrimaryStringTypeBeforeToPB called before default ToPB code
+// PrimaryStringTypeWithBeforeToPB called before default ToPB code
 type PrimaryStringTypeWithBeforeToPB interface {
 	BeforeToPB(context.Context, *PrimaryStringType) error
 }
 
-// PrimaryStringTypeAfterToPB called after default ToPB code
+// PrimaryStringTypeWithAfterToPB called after default ToPB code
 type PrimaryStringTypeWithAfterToPB interface {
 	AfterToPB(context.Context, *PrimaryStringType) error
 }
 
 type TestTagORM struct {
 	Id           string
-	TestTagAssoc *TestTagAssociationORM `gorm:"foreignkey:TestTagId;association_foreignkey:Id"`
+	TestTagAssoc *TestTagAssociationORM `gorm:"foreignKey:TestTagId;references:Id;preload:false"`
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TestTagORM) TableName() string {
 	return "test_tags"
 }
@@ -736,32 +738,32 @@ func (m *TestTagORM) ToPB(ctx context.Context) (TestTag, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestTag the arg will be the target, the caller the one being converted from
 
-// TestTagBeforeToORM called before default ToORM code
+// TestTagWithBeforeToORM called before default ToORM code
 type TestTagWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TestTagORM) error
 }
 
-// TestTagAfterToORM called after default ToORM code
+// TestTagWithAfterToORM called after default ToORM code
 type TestTagWithAfterToORM interface {
 	AfterToORM(context.Context, *TestTagORM) error
 }
 
-// TestTagBeforeToPB called before default ToPB code
+// TestTagWithBeforeToPB called before default ToPB code
 type TestTagWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TestTag) error
 }
 
-// TestTagAfterToPB called after default ToPB code
+// TestTagWithAfterToPB called after default ToPB code
 type TestTagWithAfterToPB interface {
 	AfterToPB(context.Context, *TestTag) error
 }
 
 type TestAssocHandlerDefaultORM struct {
 	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerDefaultId;association_foreignkey:Id"`
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignKey:TestAssocHandlerDefaultId;references:Id;preload:false"`
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TestAssocHandlerDefaultORM) TableName() string {
 	return "test_assoc_handler_defaults"
 }
@@ -825,32 +827,32 @@ func (m *TestAssocHandlerDefaultORM) ToPB(ctx context.Context) (TestAssocHandler
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerDefault the arg will be the target, the caller the one being converted from
 
-// TestAssocHandlerDefaultBeforeToORM called before default ToORM code
+// TestAssocHandlerDefaultWithBeforeToORM called before default ToORM code
 type TestAssocHandlerDefaultWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TestAssocHandlerDefaultORM) error
 }
 
-// TestAssocHandlerDefaultAfterToORM called after default ToORM code
+// TestAssocHandlerDefaultWithAfterToORM called after default ToORM code
 type TestAssocHandlerDefaultWithAfterToORM interface {
 	AfterToORM(context.Context, *TestAssocHandlerDefaultORM) error
 }
 
-// TestAssocHandlerDefaultBeforeToPB called before default ToPB code
+// TestAssocHandlerDefaultWithBeforeToPB called before default ToPB code
 type TestAssocHandlerDefaultWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TestAssocHandlerDefault) error
 }
 
-// TestAssocHandlerDefaultAfterToPB called after default ToPB code
+// TestAssocHandlerDefaultWithAfterToPB called after default ToPB code
 type TestAssocHandlerDefaultWithAfterToPB interface {
 	AfterToPB(context.Context, *TestAssocHandlerDefault) error
 }
 
 type TestAssocHandlerReplaceORM struct {
 	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerReplaceId;association_foreignkey:Id;replace:true"`
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignKey:TestAssocHandlerReplaceId;references:Id;preload:false"`
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TestAssocHandlerReplaceORM) TableName() string {
 	return "test_assoc_handler_replaces"
 }
@@ -914,32 +916,32 @@ func (m *TestAssocHandlerReplaceORM) ToPB(ctx context.Context) (TestAssocHandler
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerReplace the arg will be the target, the caller the one being converted from
 
-// TestAssocHandlerReplaceBeforeToORM called before default ToORM code
+// TestAssocHandlerReplaceWithBeforeToORM called before default ToORM code
 type TestAssocHandlerReplaceWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TestAssocHandlerReplaceORM) error
 }
 
-// TestAssocHandlerReplaceAfterToORM called after default ToORM code
+// TestAssocHandlerReplaceWithAfterToORM called after default ToORM code
 type TestAssocHandlerReplaceWithAfterToORM interface {
 	AfterToORM(context.Context, *TestAssocHandlerReplaceORM) error
 }
 
-// TestAssocHandlerReplaceBeforeToPB called before default ToPB code
+// TestAssocHandlerReplaceWithBeforeToPB called before default ToPB code
 type TestAssocHandlerReplaceWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TestAssocHandlerReplace) error
 }
 
-// TestAssocHandlerReplaceAfterToPB called after default ToPB code
+// TestAssocHandlerReplaceWithAfterToPB called after default ToPB code
 type TestAssocHandlerReplaceWithAfterToPB interface {
 	AfterToPB(context.Context, *TestAssocHandlerReplace) error
 }
 
 type TestAssocHandlerClearORM struct {
 	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerClearId;association_foreignkey:Id;clear:true"`
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignKey:TestAssocHandlerClearId;references:Id;preload:false"`
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TestAssocHandlerClearORM) TableName() string {
 	return "test_assoc_handler_clears"
 }
@@ -1003,32 +1005,32 @@ func (m *TestAssocHandlerClearORM) ToPB(ctx context.Context) (TestAssocHandlerCl
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerClear the arg will be the target, the caller the one being converted from
 
-// TestAssocHandlerClearBeforeToORM called before default ToORM code
+// TestAssocHandlerClearWithBeforeToORM called before default ToORM code
 type TestAssocHandlerClearWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TestAssocHandlerClearORM) error
 }
 
-// TestAssocHandlerClearAfterToORM called after default ToORM code
+// TestAssocHandlerClearWithAfterToORM called after default ToORM code
 type TestAssocHandlerClearWithAfterToORM interface {
 	AfterToORM(context.Context, *TestAssocHandlerClearORM) error
 }
 
-// TestAssocHandlerClearBeforeToPB called before default ToPB code
+// TestAssocHandlerClearWithBeforeToPB called before default ToPB code
 type TestAssocHandlerClearWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TestAssocHandlerClear) error
 }
 
-// TestAssocHandlerClearAfterToPB called after default ToPB code
+// TestAssocHandlerClearWithAfterToPB called after default ToPB code
 type TestAssocHandlerClearWithAfterToPB interface {
 	AfterToPB(context.Context, *TestAssocHandlerClear) error
 }
 
 type TestAssocHandlerAppendORM struct {
 	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerAppendId;association_foreignkey:Id;append:true"`
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignKey:TestAssocHandlerAppendId;references:Id;preload:false"`
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TestAssocHandlerAppendORM) TableName() string {
 	return "test_assoc_handler_appends"
 }
@@ -1092,22 +1094,22 @@ func (m *TestAssocHandlerAppendORM) ToPB(ctx context.Context) (TestAssocHandlerA
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerAppend the arg will be the target, the caller the one being converted from
 
-// TestAssocHandlerAppendBeforeToORM called before default ToORM code
+// TestAssocHandlerAppendWithBeforeToORM called before default ToORM code
 type TestAssocHandlerAppendWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TestAssocHandlerAppendORM) error
 }
 
-// TestAssocHandlerAppendAfterToORM called after default ToORM code
+// TestAssocHandlerAppendWithAfterToORM called after default ToORM code
 type TestAssocHandlerAppendWithAfterToORM interface {
 	AfterToORM(context.Context, *TestAssocHandlerAppendORM) error
 }
 
-// TestAssocHandlerAppendBeforeToPB called before default ToPB code
+// TestAssocHandlerAppendWithBeforeToPB called before default ToPB code
 type TestAssocHandlerAppendWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TestAssocHandlerAppend) error
 }
 
-// TestAssocHandlerAppendAfterToPB called after default ToPB code
+// TestAssocHandlerAppendWithAfterToPB called after default ToPB code
 type TestAssocHandlerAppendWithAfterToPB interface {
 	AfterToPB(context.Context, *TestAssocHandlerAppend) error
 }
@@ -1121,7 +1123,7 @@ type TestTagAssociationORM struct {
 	TestTagId                 *string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TestTagAssociationORM) TableName() string {
 	return "test_tag_associations"
 }
@@ -1163,32 +1165,32 @@ func (m *TestTagAssociationORM) ToPB(ctx context.Context) (TestTagAssociation, e
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestTagAssociation the arg will be the target, the caller the one being converted from
 
-// TestTagAssociationBeforeToORM called before default ToORM code
+// TestTagAssociationWithBeforeToORM called before default ToORM code
 type TestTagAssociationWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TestTagAssociationORM) error
 }
 
-// TestTagAssociationAfterToORM called after default ToORM code
+// TestTagAssociationWithAfterToORM called after default ToORM code
 type TestTagAssociationWithAfterToORM interface {
 	AfterToORM(context.Context, *TestTagAssociationORM) error
 }
 
-// TestTagAssociationBeforeToPB called before default ToPB code
+// TestTagAssociationWithBeforeToPB called before default ToPB code
 type TestTagAssociationWithBeforeToPB interface {
 	BeforeToPB(context.Context, *TestTagAssociation) error
 }
 
-// TestTagAssociationAfterToPB called after default ToPB code
+// TestTagAssociationWithAfterToPB called after default ToPB code
 type TestTagAssociationWithAfterToPB interface {
 	AfterToPB(context.Context, *TestTagAssociation) error
 }
 
 type PrimaryIncludedORM struct {
-	Child *ExternalChildORM `gorm:"foreignkey:PrimaryIncludedId;association_foreignkey:Id"`
-	Id    _go.UUID
+	Child *ExternalChildORM `gorm:"foreignKey:PrimaryIncludedId;references:Id"`
+	Id    go_uuid.UUID
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (PrimaryIncludedORM) TableName() string {
 	return "primary_includeds"
 }
@@ -1242,22 +1244,22 @@ func (m *PrimaryIncludedORM) ToPB(ctx context.Context) (PrimaryIncluded, error)
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type PrimaryIncluded the arg will be the target, the caller the one being converted from
 
-// PrimaryIncludedBeforeToORM called before default ToORM code
+// PrimaryIncludedWithBeforeToORM called before default ToORM code
 type PrimaryIncludedWithBeforeToORM interface {
 	BeforeToORM(context.Context, *PrimaryIncludedORM) error
 }
 
-// PrimaryIncludedAfterToORM called after default ToORM code
+// PrimaryIncludedWithAfterToORM called after default ToORM code
 type PrimaryIncludedWithAfterToORM interface {
 	AfterToORM(context.Context, *PrimaryIncludedORM) error
 }
 
-// PrimaryIncludedBeforeToPB called before default ToPB code
+// PrimaryIncludedWithBeforeToPB called before default ToPB code
 type PrimaryIncludedWithBeforeToPB interface {
 	BeforeToPB(context.Context, *PrimaryIncluded) error
 }
 
-// PrimaryIncludedAfterToPB called after default ToPB code
+// PrimaryIncludedWithAfterToPB called after default ToPB code
 type PrimaryIncludedWithAfterToPB interface {
 	AfterToPB(context.Context, *PrimaryIncluded) error
 }
@@ -1305,6 +1307,7 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 	var err error
 	var updatedOptionalString bool
 	var updatedNothingness bool
+	var updatedCreatedAt bool
 	var updatedJsonField bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"ApiOnlyString" {
@@ -1321,7 +1324,7 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 				continue
 			}
 			if patchee.OptionalString == nil {
-				patchee.OptionalString = &wrappers.StringValue{}
+				patchee.OptionalString = &wrapperspb.StringValue{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1348,7 +1351,7 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 				continue
 			}
 			if patchee.Nothingness == nil {
-				patchee.Nothingness = &empty.Empty{}
+				patchee.Nothingness = &emptypb.Empty{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1369,7 +1372,26 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 			patchee.Uuid = patcher.Uuid
 			continue
 		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
 		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
 			patchee.CreatedAt = patcher.CreatedAt
 			continue
 		}
@@ -1418,7 +1440,6 @@ func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	ormResponse := []TestTypesORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -1508,7 +1529,7 @@ func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*T
 		}
 	}
 	ormResponse := TypeWithIDORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&TypeWithIDORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(TypeWithIDORMWithAfterReadFind); ok {
@@ -1546,7 +1567,7 @@ func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) e
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TypeWithIDORM{}).Error
+	err = db.Where(&TypeWithIDORM{Id: ormObj.Id}).Delete(&TypeWithIDORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -1604,14 +1625,15 @@ type TypeWithIDORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTypeWithID")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateTypeWithID")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &TypeWithIDORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -1652,6 +1674,9 @@ func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -1677,7 +1702,7 @@ func DefaultPatchTypeWithID(ctx context.Context, in *TypeWithID, updateMask *fie
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTypeWithID(ctx, &TypeWithID{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTypeWithID(ctx, &TypeWithID{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -1753,6 +1778,7 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 	var updatedSyntheticField bool
 	var updatedFloatField bool
 	var updatedDoubleField bool
+	var updatedDeletedAt bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
@@ -1874,7 +1900,7 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 				continue
 			}
 			if patchee.FloatField == nil {
-				patchee.FloatField = &wrappers.FloatValue{}
+				patchee.FloatField = &wrapperspb.FloatValue{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1897,7 +1923,7 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 				continue
 			}
 			if patchee.DoubleField == nil {
-				patchee.DoubleField = &wrappers.DoubleValue{}
+				patchee.DoubleField = &wrapperspb.DoubleValue{}
 			}
 			childMask := &field_mask.FieldMask{}
 			for j := i; j < len(updateMask.Paths); j++ {
@@ -1918,7 +1944,26 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 			patchee.TimeOnly = patcher.TimeOnly
 			continue
 		}
+		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
+			if patcher.DeletedAt == nil {
+				patchee.DeletedAt = nil
+				continue
+			}
+			if patchee.DeletedAt == nil {
+				patchee.DeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
 		if f == prefix+"DeletedAt" {
+			updatedDeletedAt = true
 			patchee.DeletedAt = patcher.DeletedAt
 			continue
 		}
@@ -1950,7 +1995,6 @@ func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, err
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []TypeWithIDORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -2041,7 +2085,7 @@ func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountType
 		}
 	}
 	ormResponse := MultiaccountTypeWithIDORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&MultiaccountTypeWithIDORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
@@ -2079,7 +2123,7 @@ func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{}).Error
+	err = db.Where(&MultiaccountTypeWithIDORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).Delete(&MultiaccountTypeWithIDORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -2141,7 +2185,7 @@ type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -2152,8 +2196,9 @@ func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *Multiacc
 		return nil, err
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
 	lockedRow := &MultiaccountTypeWithIDORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -2176,6 +2221,9 @@ func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *Multiacc
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -2201,7 +2249,7 @@ func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTyp
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2308,7 +2356,7 @@ func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*Mul
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&MultiaccountTypeWithIDORM{AccountID: ormObj.AccountID})
 	db = db.Order("id")
 	ormResponse := []MultiaccountTypeWithIDORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -2414,7 +2462,7 @@ func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&MultiaccountTypeWithoutIDORM{AccountID: ormObj.AccountID})
 	ormResponse := []MultiaccountTypeWithoutIDORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -2487,7 +2535,7 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == nil || *ormObj.Id == _go.Nil {
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
 		return nil, errors.EmptyIdError
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadApplyQuery); ok {
@@ -2504,7 +2552,7 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 		}
 	}
 	ormResponse := PrimaryUUIDTypeORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&PrimaryUUIDTypeORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
@@ -2534,7 +2582,7 @@ func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == nil || *ormObj.Id == _go.Nil {
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
 		return errors.EmptyIdError
 	}
 	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeDelete_); ok {
@@ -2542,7 +2590,7 @@ func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{}).Error
+	err = db.Where(&PrimaryUUIDTypeORM{Id: ormObj.Id}).Delete(&PrimaryUUIDTypeORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -2564,13 +2612,13 @@ func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType,
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []*_go.UUID{}
+	keys := []*go_uuid.UUID{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == nil || *ormObj.Id == _go.Nil {
+		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
@@ -2600,24 +2648,25 @@ type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
 // DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdatePrimaryUUIDType")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &PrimaryUUIDTypeORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	filterChild := ExternalChildORM{}
-	if ormObj.Id == nil || *ormObj.Id == _go.Nil {
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
 		return nil, errors.EmptyIdError
 	}
-	filterChild.PrimaryUUIDTypeId = new(_go.UUID)
+	filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
 	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
 	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
 		return nil, err
@@ -2639,6 +2688,9 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -2664,7 +2716,7 @@ func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updat
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2789,7 +2841,6 @@ func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUI
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []PrimaryUUIDTypeORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -2880,7 +2931,7 @@ func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db
 		}
 	}
 	ormResponse := PrimaryStringTypeORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&PrimaryStringTypeORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
@@ -2918,7 +2969,7 @@ func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryStringTypeORM{}).Error
+	err = db.Where(&PrimaryStringTypeORM{Id: ormObj.Id}).Delete(&PrimaryStringTypeORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -2976,14 +3027,15 @@ type PrimaryStringTypeORMWithAfterDeleteSet interface {
 // DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdatePrimaryStringType")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &PrimaryStringTypeORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -3015,6 +3067,9 @@ func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryString
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -3040,7 +3095,7 @@ func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, u
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -3165,7 +3220,6 @@ func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryS
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []PrimaryStringTypeORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -3256,7 +3310,7 @@ func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag
 		}
 	}
 	ormResponse := TestTagORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&TestTagORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
@@ -3294,7 +3348,7 @@ func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestTagORM{}).Error
+	err = db.Where(&TestTagORM{Id: ormObj.Id}).Delete(&TestTagORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -3352,14 +3406,15 @@ type TestTagORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateTestTag")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &TestTagORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -3391,6 +3446,9 @@ func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -3416,7 +3474,7 @@ func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mas
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestTag(ctx, &TestTag{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestTag(ctx, &TestTag{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -3541,7 +3599,6 @@ func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []TestTagORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -3632,7 +3689,7 @@ func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandle
 		}
 	}
 	ormResponse := TestAssocHandlerDefaultORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&TestAssocHandlerDefaultORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
@@ -3670,7 +3727,7 @@ func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHand
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{}).Error
+	err = db.Where(&TestAssocHandlerDefaultORM{Id: ormObj.Id}).Delete(&TestAssocHandlerDefaultORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -3728,14 +3785,15 @@ type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &TestAssocHandlerDefaultORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -3767,6 +3825,9 @@ func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAss
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -3792,7 +3853,7 @@ func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandl
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -3899,7 +3960,6 @@ func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*Te
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerDefaultORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -3990,7 +4050,7 @@ func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandle
 		}
 	}
 	ormResponse := TestAssocHandlerReplaceORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&TestAssocHandlerReplaceORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
@@ -4028,7 +4088,7 @@ func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{}).Error
+	err = db.Where(&TestAssocHandlerReplaceORM{Id: ormObj.Id}).Delete(&TestAssocHandlerReplaceORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -4086,14 +4146,15 @@ type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &TestAssocHandlerReplaceORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -4120,6 +4181,9 @@ func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAss
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -4145,7 +4209,7 @@ func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandl
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -4252,7 +4316,6 @@ func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*Te
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerReplaceORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -4343,7 +4406,7 @@ func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerC
 		}
 	}
 	ormResponse := TestAssocHandlerClearORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&TestAssocHandlerClearORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
@@ -4381,7 +4444,7 @@ func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandle
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{}).Error
+	err = db.Where(&TestAssocHandlerClearORM{Id: ormObj.Id}).Delete(&TestAssocHandlerClearORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -4439,14 +4502,15 @@ type TestAssocHandlerClearORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateTestAssocHandlerClear")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &TestAssocHandlerClearORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -4473,6 +4537,9 @@ func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssoc
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -4498,7 +4565,7 @@ func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandler
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -4605,7 +4672,6 @@ func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*Test
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerClearORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -4696,7 +4762,7 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 		}
 	}
 	ormResponse := TestAssocHandlerAppendORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&TestAssocHandlerAppendORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
@@ -4734,7 +4800,7 @@ func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{}).Error
+	err = db.Where(&TestAssocHandlerAppendORM{Id: ormObj.Id}).Delete(&TestAssocHandlerAppendORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -4792,14 +4858,15 @@ type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var count int64
 	lockedRow := &TestAssocHandlerAppendORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	count = db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
 	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -4826,6 +4893,9 @@ func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAsso
 	if err != nil {
 		return nil, err
 	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
@@ -4851,7 +4921,7 @@ func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandle
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -4958,7 +5028,6 @@ func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*Tes
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []TestAssocHandlerAppendORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -5064,7 +5133,6 @@ func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTag
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	ormResponse := []TestTagAssociationORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -5187,7 +5255,6 @@ func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryInc
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []PrimaryIncludedORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -0,0 +1,24 @@
+package features
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NormalizeEmail lower cases an email, an email without an @ is rejected
+func NormalizeEmail(email string) (string, error) {
+	if email != "" && !strings.Contains(email, "@") {
+		return "", fmt.Errorf("invalid email %q", email)
+	}
+	return strings.ToLower(email), nil
+}
+
+// PadName surrounds a stored name with a space on each side
+func PadName(name string) (string, error) {
+	return " " + name + " ", nil
+}
+
+// TrimName trims the spaces around a name
+func TrimName(name string) (string, error) {
+	return strings.TrimSpace(name), nil
+}
@@ -0,0 +1,31 @@
+package features
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCustomConversions(t *testing.T) {
+	db := openDB(t, &AccountORM{})
+	ctx := context.Background()
+	account, err := DefaultCreateAccount(ctx, &Account{Email: "Ann@Example.com", DisplayName: "  Ann "}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if account.Email != "ann@example.com" || account.DisplayName != " Ann " {
+		t.Errorf("Unexpected created account %v", account)
+	}
+	var row struct {
+		Email       string
+		DisplayName string
+	}
+	if err := db.Table("accounts").Where("id = ?", account.Id).Scan(&row).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if row.Email != "ann@example.com" || row.DisplayName != "Ann" {
+		t.Errorf("Unexpected columns of the account: %+v", row)
+	}
+	if _, err := DefaultCreateAccount(ctx, &Account{Email: "ann"}, db); err == nil {
+		t.Errorf("Expected an error creating an account of an invalid email")
+	}
+}
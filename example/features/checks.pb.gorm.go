@@ -0,0 +1,909 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	gorm "gorm.io/gorm"
+)
+
+type BookingORM struct {
+	Attendees []*AttendeeORM `gorm:"foreignKey:BookingId;references:Id;constraint:OnDelete:CASCADE,OnUpdate:SET NULL"`
+	EndAt     int64          `gorm:"check:chk_period,start_at < end_at"`
+	Guests    int32          `gorm:"check:guests >= 0"`
+	Id        uint64
+	Seats     int32 `gorm:"check:chk_seats,seats BETWEEN 1 AND 10"`
+	StartAt   int64
+	Title     string `gorm:"check:chk_title,title <> 'seats'"`
+}
+
+// TableName overrides the default table name generated by GORM
+func (BookingORM) TableName() string {
+	return "bookings"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Booking) ToORM(ctx context.Context) (BookingORM, error) {
+	to := BookingORM{}
+	var err error
+	if prehook, ok := interface{}(m).(BookingWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	to.StartAt = m.StartAt
+	to.EndAt = m.EndAt
+	to.Seats = m.Seats
+	to.Guests = m.Guests
+	for _, v := range m.Attendees {
+		if v != nil {
+			if tempAttendees, cErr := v.ToORM(ctx); cErr == nil {
+				to.Attendees = append(to.Attendees, &tempAttendees)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Attendees = append(to.Attendees, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(BookingWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *BookingORM) ToPB(ctx context.Context) (Booking, error) {
+	to := Booking{}
+	var err error
+	if prehook, ok := interface{}(m).(BookingWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	to.StartAt = m.StartAt
+	to.EndAt = m.EndAt
+	to.Seats = m.Seats
+	to.Guests = m.Guests
+	for _, v := range m.Attendees {
+		if v != nil {
+			if tempAttendees, cErr := v.ToPB(ctx); cErr == nil {
+				to.Attendees = append(to.Attendees, &tempAttendees)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Attendees = append(to.Attendees, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(BookingWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Booking the arg will be the target, the caller the one being converted from
+
+// BookingWithBeforeToORM called before default ToORM code
+type BookingWithBeforeToORM interface {
+	BeforeToORM(context.Context, *BookingORM) error
+}
+
+// BookingWithAfterToORM called after default ToORM code
+type BookingWithAfterToORM interface {
+	AfterToORM(context.Context, *BookingORM) error
+}
+
+// BookingWithBeforeToPB called before default ToPB code
+type BookingWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Booking) error
+}
+
+// BookingWithAfterToPB called after default ToPB code
+type BookingWithAfterToPB interface {
+	AfterToPB(context.Context, *Booking) error
+}
+
+type AttendeeORM struct {
+	BookingId *uint64
+	Id        uint64
+	Name      string
+}
+
+// TableName overrides the default table name generated by GORM
+func (AttendeeORM) TableName() string {
+	return "attendees"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Attendee) ToORM(ctx context.Context) (AttendeeORM, error) {
+	to := AttendeeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(AttendeeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(AttendeeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *AttendeeORM) ToPB(ctx context.Context) (Attendee, error) {
+	to := Attendee{}
+	var err error
+	if prehook, ok := interface{}(m).(AttendeeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(AttendeeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Attendee the arg will be the target, the caller the one being converted from
+
+// AttendeeWithBeforeToORM called before default ToORM code
+type AttendeeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *AttendeeORM) error
+}
+
+// AttendeeWithAfterToORM called after default ToORM code
+type AttendeeWithAfterToORM interface {
+	AfterToORM(context.Context, *AttendeeORM) error
+}
+
+// AttendeeWithBeforeToPB called before default ToPB code
+type AttendeeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Attendee) error
+}
+
+// AttendeeWithAfterToPB called after default ToPB code
+type AttendeeWithAfterToPB interface {
+	AfterToPB(context.Context, *Attendee) error
+}
+
+// DefaultCreateBooking executes a basic gorm create call
+func DefaultCreateBooking(ctx context.Context, in *Booking, db *gorm.DB) (*Booking, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BookingORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadBooking executes a basic gorm read call
+func DefaultReadBooking(ctx context.Context, in *Booking, db *gorm.DB) (*Booking, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &BookingORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := BookingORM{}
+	if err = db.Where(&BookingORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(BookingORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BookingORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBooking(ctx context.Context, in *Booking, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&BookingORM{Id: ormObj.Id}).Delete(&BookingORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type BookingORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBookingSet(ctx context.Context, in []*Booking, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&BookingORM{})).(BookingORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&BookingORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&BookingORM{})).(BookingORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type BookingORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Booking, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Booking, *gorm.DB) error
+}
+
+// DefaultStrictUpdateBooking clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateBooking(ctx context.Context, in *Booking, db *gorm.DB) (*Booking, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateBooking")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &BookingORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterAttendees := AttendeeORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterAttendees.BookingId = new(uint64)
+	*filterAttendees.BookingId = ormObj.Id
+	if err = db.Where(filterAttendees).Delete(AttendeeORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type BookingORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchBooking executes a basic gorm update call with patch behavior
+func DefaultPatchBooking(ctx context.Context, in *Booking, updateMask *field_mask.FieldMask, db *gorm.DB) (*Booking, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Booking
+	var err error
+	if hook, ok := interface{}(&pbObj).(BookingWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadBooking(ctx, &Booking{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(BookingWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskBooking(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(BookingWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateBooking(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(BookingWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type BookingWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Booking, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BookingWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Booking, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BookingWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Booking, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BookingWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Booking, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetBooking executes a bulk gorm update call with patch behavior
+func DefaultPatchSetBooking(ctx context.Context, objects []*Booking, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Booking, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Booking, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchBooking(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskBooking patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskBooking(ctx context.Context, patchee *Booking, patcher *Booking, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Booking, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"StartAt" {
+			patchee.StartAt = patcher.StartAt
+			continue
+		}
+		if f == prefix+"EndAt" {
+			patchee.EndAt = patcher.EndAt
+			continue
+		}
+		if f == prefix+"Seats" {
+			patchee.Seats = patcher.Seats
+			continue
+		}
+		if f == prefix+"Guests" {
+			patchee.Guests = patcher.Guests
+			continue
+		}
+		if f == prefix+"Attendees" {
+			patchee.Attendees = patcher.Attendees
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListBooking executes a gorm list call
+func DefaultListBooking(ctx context.Context, db *gorm.DB) ([]*Booking, error) {
+	in := Booking{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &BookingORM{}, &Booking{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []BookingORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BookingORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Booking{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type BookingORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BookingORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]BookingORM) error
+}
+
+// DefaultCreateAttendee executes a basic gorm create call
+func DefaultCreateAttendee(ctx context.Context, in *Attendee, db *gorm.DB) (*Attendee, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AttendeeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadAttendee executes a basic gorm read call
+func DefaultReadAttendee(ctx context.Context, in *Attendee, db *gorm.DB) (*Attendee, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AttendeeORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := AttendeeORM{}
+	if err = db.Where(&AttendeeORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(AttendeeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AttendeeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAttendee(ctx context.Context, in *Attendee, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&AttendeeORM{Id: ormObj.Id}).Delete(&AttendeeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type AttendeeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAttendeeSet(ctx context.Context, in []*Attendee, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&AttendeeORM{})).(AttendeeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&AttendeeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&AttendeeORM{})).(AttendeeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type AttendeeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Attendee, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Attendee, *gorm.DB) error
+}
+
+// DefaultStrictUpdateAttendee clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAttendee(ctx context.Context, in *Attendee, db *gorm.DB) (*Attendee, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateAttendee")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &AttendeeORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type AttendeeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAttendee executes a basic gorm update call with patch behavior
+func DefaultPatchAttendee(ctx context.Context, in *Attendee, updateMask *field_mask.FieldMask, db *gorm.DB) (*Attendee, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Attendee
+	var err error
+	if hook, ok := interface{}(&pbObj).(AttendeeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAttendee(ctx, &Attendee{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AttendeeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAttendee(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AttendeeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAttendee(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AttendeeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AttendeeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Attendee, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Attendee, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Attendee, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Attendee, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAttendee executes a bulk gorm update call with patch behavior
+func DefaultPatchSetAttendee(ctx context.Context, objects []*Attendee, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Attendee, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Attendee, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchAttendee(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskAttendee patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAttendee(ctx context.Context, patchee *Attendee, patcher *Attendee, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Attendee, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAttendee executes a gorm list call
+func DefaultListAttendee(ctx context.Context, db *gorm.DB) ([]*Attendee, error) {
+	in := Attendee{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AttendeeORM{}, &Attendee{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []AttendeeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttendeeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Attendee{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AttendeeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttendeeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AttendeeORM) error
+}
@@ -0,0 +1,57 @@
+package features
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestChecks(t *testing.T) {
+	db := openDB(t, &BookingORM{}, &AttendeeORM{})
+	ctx := context.Background()
+	if _, err := DefaultCreateBooking(ctx, &Booking{Title: "a", StartAt: 1, EndAt: 2, Seats: 2}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	for _, booking := range []*Booking{
+		{Title: "b", StartAt: 2, EndAt: 1, Seats: 2},
+		{Title: "seats", StartAt: 1, EndAt: 2, Seats: 2},
+		{Title: "c", StartAt: 1, EndAt: 2, Seats: 11},
+		{Title: "d", StartAt: 1, EndAt: 2, Seats: 2, Guests: -1},
+	} {
+		if _, err := DefaultCreateBooking(ctx, booking, db); err == nil {
+			t.Errorf("Expected booking %v to violate a check", booking)
+		}
+	}
+	for _, name := range []string{"chk_period", "chk_title", "chk_seats"} {
+		if !db.Migrator().HasConstraint(&BookingORM{}, name) {
+			t.Errorf("Expected constraint %s", name)
+		}
+	}
+
+}
+
+func TestForeignKeyActions(t *testing.T) {
+	db := openDB(t, &BookingORM{}, &AttendeeORM{})
+	ctx := context.Background()
+	var sql string
+	if err := db.Raw("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'attendees'").Scan(&sql).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if !strings.Contains(sql, "ON DELETE CASCADE ON UPDATE SET NULL") {
+		t.Errorf("Expected the foreign key actions in %q", sql)
+	}
+	if err := db.Exec("PRAGMA foreign_keys = ON").Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	booking, err := DefaultCreateBooking(ctx, &Booking{Title: "a", StartAt: 1, EndAt: 2, Seats: 2, Attendees: []*Attendee{{Name: "ann"}}}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if err := db.Exec("DELETE FROM bookings WHERE id = ?", booking.Id).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	var count int64
+	if err := db.Table("attendees").Count(&count).Error; err != nil || count != 0 {
+		t.Errorf("Expected the attendees deleted with their booking, got %d, %v", count, err)
+	}
+}
@@ -0,0 +1,438 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	gorm "gorm.io/gorm"
+)
+
+type SlotORM struct {
+	Label    string
+	Position int32  `gorm:"primaryKey"`
+	TenantId string `gorm:"primaryKey"`
+}
+
+// TableName overrides the default table name generated by GORM
+func (SlotORM) TableName() string {
+	return "slots"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Slot) ToORM(ctx context.Context) (SlotORM, error) {
+	to := SlotORM{}
+	var err error
+	if prehook, ok := interface{}(m).(SlotWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.TenantId = m.TenantId
+	to.Position = m.Position
+	to.Label = m.Label
+	if posthook, ok := interface{}(m).(SlotWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *SlotORM) ToPB(ctx context.Context) (Slot, error) {
+	to := Slot{}
+	var err error
+	if prehook, ok := interface{}(m).(SlotWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.TenantId = m.TenantId
+	to.Position = m.Position
+	to.Label = m.Label
+	if posthook, ok := interface{}(m).(SlotWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Slot the arg will be the target, the caller the one being converted from
+
+// SlotWithBeforeToORM called before default ToORM code
+type SlotWithBeforeToORM interface {
+	BeforeToORM(context.Context, *SlotORM) error
+}
+
+// SlotWithAfterToORM called after default ToORM code
+type SlotWithAfterToORM interface {
+	AfterToORM(context.Context, *SlotORM) error
+}
+
+// SlotWithBeforeToPB called before default ToPB code
+type SlotWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Slot) error
+}
+
+// SlotWithAfterToPB called after default ToPB code
+type SlotWithAfterToPB interface {
+	AfterToPB(context.Context, *Slot) error
+}
+
+// DefaultCreateSlot executes a basic gorm create call
+func DefaultCreateSlot(ctx context.Context, in *Slot, db *gorm.DB) (*Slot, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SlotORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadSlot executes a basic gorm read call
+func DefaultReadSlot(ctx context.Context, in *Slot, db *gorm.DB) (*Slot, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.TenantId == "" || ormObj.Position == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &SlotORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SlotORM{}
+	if err = db.Where(&SlotORM{TenantId: ormObj.TenantId, Position: ormObj.Position}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SlotORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SlotORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSlot(ctx context.Context, in *Slot, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.TenantId == "" || ormObj.Position == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&SlotORM{TenantId: ormObj.TenantId, Position: ormObj.Position}).Delete(&SlotORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type SlotORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSlotSet(ctx context.Context, in []*Slot, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := [][]interface{}{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.TenantId == "" || ormObj.Position == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, []interface{}{ormObj.TenantId, ormObj.Position})
+	}
+	if hook, ok := (interface{}(&SlotORM{})).(SlotORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	keysWhere := db.Session(&gorm.Session{NewDB: true}).Where("1 = 0")
+	for _, key := range keys {
+		keysWhere = keysWhere.Or("tenant_id = ? AND position = ?", key...)
+	}
+	err = db.Where(keysWhere).Delete(&SlotORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&SlotORM{})).(SlotORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type SlotORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Slot, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Slot, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSlot clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSlot(ctx context.Context, in *Slot, db *gorm.DB) (*Slot, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateSlot")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &SlotORM{}
+	db.Model(&ormObj).Where("tenant_id=? AND position=?", ormObj.TenantId, ormObj.Position).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type SlotORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSlot executes a basic gorm update call with patch behavior
+func DefaultPatchSlot(ctx context.Context, in *Slot, updateMask *field_mask.FieldMask, db *gorm.DB) (*Slot, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Slot
+	var err error
+	if hook, ok := interface{}(&pbObj).(SlotWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadSlot(ctx, &Slot{TenantId: in.TenantId, Position: in.Position}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(SlotWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSlot(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(SlotWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSlot(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(SlotWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type SlotWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Slot, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SlotWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Slot, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SlotWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Slot, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SlotWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Slot, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSlot executes a bulk gorm update call with patch behavior
+func DefaultPatchSetSlot(ctx context.Context, objects []*Slot, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Slot, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Slot, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchSlot(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSlot patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSlot(ctx context.Context, patchee *Slot, patcher *Slot, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Slot, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"TenantId" {
+			patchee.TenantId = patcher.TenantId
+			continue
+		}
+		if f == prefix+"Position" {
+			patchee.Position = patcher.Position
+			continue
+		}
+		if f == prefix+"Label" {
+			patchee.Label = patcher.Label
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSlot executes a gorm list call
+func DefaultListSlot(ctx context.Context, db *gorm.DB) ([]*Slot, error) {
+	in := Slot{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &SlotORM{}, &Slot{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("tenant_id, position")
+	ormResponse := []SlotORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SlotORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Slot{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SlotORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SlotORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SlotORM) error
+}
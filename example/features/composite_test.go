@@ -0,0 +1,44 @@
+package features
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCompositeKeyHandlers(t *testing.T) {
+	db := openDB(t, &SlotORM{})
+	ctx := context.Background()
+	for _, slot := range []*Slot{
+		{TenantId: "t2", Position: 1, Label: "c"},
+		{TenantId: "t1", Position: 2, Label: "b"},
+		{TenantId: "t1", Position: 1, Label: "a"},
+	} {
+		if _, err := DefaultCreateSlot(ctx, slot, db); err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+	}
+	if _, err := DefaultReadSlot(ctx, &Slot{TenantId: "t1"}, db); err == nil {
+		t.Error("Expected error reading without the full key but didn't get any")
+	}
+	slot, err := DefaultReadSlot(ctx, &Slot{TenantId: "t1", Position: 2}, db)
+	if err != nil || slot.Label != "b" {
+		t.Fatalf("Expected slot b, got %v, %v", slot, err)
+	}
+	if _, err := DefaultStrictUpdateSlot(ctx, &Slot{TenantId: "t1", Position: 2, Label: "B"}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if err := DefaultDeleteSlot(ctx, &Slot{TenantId: "t2", Position: 1}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	list, err := DefaultListSlot(ctx, db)
+	if err != nil || len(list) != 2 || list[0].Label != "a" || list[1].Label != "B" {
+		t.Fatalf("Expected slots a and B, got %v, %v", list, err)
+	}
+	if err := DefaultDeleteSlotSet(ctx, []*Slot{{TenantId: "t1", Position: 1}}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	list, err = DefaultListSlot(ctx, db)
+	if err != nil || len(list) != 1 || list[0].Label != "B" {
+		t.Fatalf("Expected slot B, got %v, %v", list, err)
+	}
+}
@@ -0,0 +1,444 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	types "github.com/kirinse/protoc-gen-gorm/types"
+	date "google.golang.org/genproto/googleapis/type/date"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	gorm "gorm.io/gorm"
+)
+
+type HolidayORM struct {
+	Day      *types.Date
+	Id       uint64
+	Observed *types.Date
+}
+
+// TableName overrides the default table name generated by GORM
+func (HolidayORM) TableName() string {
+	return "holidays"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Holiday) ToORM(ctx context.Context) (HolidayORM, error) {
+	to := HolidayORM{}
+	var err error
+	if prehook, ok := interface{}(m).(HolidayWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Day != nil {
+		to.Day = &types.Date{Year: m.Day.Year, Month: m.Day.Month, Day: m.Day.Day}
+	}
+	if m.Observed != nil {
+		to.Observed = &types.Date{Year: m.Observed.Year, Month: m.Observed.Month, Day: m.Observed.Day}
+	}
+	if posthook, ok := interface{}(m).(HolidayWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *HolidayORM) ToPB(ctx context.Context) (Holiday, error) {
+	to := Holiday{}
+	var err error
+	if prehook, ok := interface{}(m).(HolidayWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Day != nil {
+		to.Day = &types.Date{Year: m.Day.Year, Month: m.Day.Month, Day: m.Day.Day}
+	}
+	if m.Observed != nil {
+		to.Observed = &date.Date{Year: m.Observed.Year, Month: m.Observed.Month, Day: m.Observed.Day}
+	}
+	if posthook, ok := interface{}(m).(HolidayWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Holiday the arg will be the target, the caller the one being converted from
+
+// HolidayWithBeforeToORM called before default ToORM code
+type HolidayWithBeforeToORM interface {
+	BeforeToORM(context.Context, *HolidayORM) error
+}
+
+// HolidayWithAfterToORM called after default ToORM code
+type HolidayWithAfterToORM interface {
+	AfterToORM(context.Context, *HolidayORM) error
+}
+
+// HolidayWithBeforeToPB called before default ToPB code
+type HolidayWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Holiday) error
+}
+
+// HolidayWithAfterToPB called after default ToPB code
+type HolidayWithAfterToPB interface {
+	AfterToPB(context.Context, *Holiday) error
+}
+
+// DefaultCreateHoliday executes a basic gorm create call
+func DefaultCreateHoliday(ctx context.Context, in *Holiday, db *gorm.DB) (*Holiday, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type HolidayORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadHoliday executes a basic gorm read call
+func DefaultReadHoliday(ctx context.Context, in *Holiday, db *gorm.DB) (*Holiday, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &HolidayORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := HolidayORM{}
+	if err = db.Where(&HolidayORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(HolidayORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type HolidayORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteHoliday(ctx context.Context, in *Holiday, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&HolidayORM{Id: ormObj.Id}).Delete(&HolidayORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type HolidayORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteHolidaySet(ctx context.Context, in []*Holiday, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&HolidayORM{})).(HolidayORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&HolidayORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&HolidayORM{})).(HolidayORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type HolidayORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Holiday, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Holiday, *gorm.DB) error
+}
+
+// DefaultStrictUpdateHoliday clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateHoliday(ctx context.Context, in *Holiday, db *gorm.DB) (*Holiday, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateHoliday")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &HolidayORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type HolidayORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchHoliday executes a basic gorm update call with patch behavior
+func DefaultPatchHoliday(ctx context.Context, in *Holiday, updateMask *field_mask.FieldMask, db *gorm.DB) (*Holiday, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Holiday
+	var err error
+	if hook, ok := interface{}(&pbObj).(HolidayWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadHoliday(ctx, &Holiday{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(HolidayWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskHoliday(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(HolidayWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateHoliday(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(HolidayWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type HolidayWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Holiday, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Holiday, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Holiday, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Holiday, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetHoliday executes a bulk gorm update call with patch behavior
+func DefaultPatchSetHoliday(ctx context.Context, objects []*Holiday, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Holiday, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Holiday, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchHoliday(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskHoliday patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskHoliday(ctx context.Context, patchee *Holiday, patcher *Holiday, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Holiday, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Day" {
+			patchee.Day = patcher.Day
+			continue
+		}
+		if f == prefix+"Observed" {
+			patchee.Observed = patcher.Observed
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListHoliday executes a gorm list call
+func DefaultListHoliday(ctx context.Context, db *gorm.DB) ([]*Holiday, error) {
+	in := Holiday{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &HolidayORM{}, &Holiday{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []HolidayORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HolidayORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Holiday{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type HolidayORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HolidayORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]HolidayORM) error
+}
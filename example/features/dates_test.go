@@ -0,0 +1,31 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirinse/protoc-gen-gorm/types"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDates(t *testing.T) {
+	db := openDB(t, &HolidayORM{})
+	ctx := context.Background()
+	holiday := &Holiday{
+		Day:      &types.Date{Year: 2021, Month: 12, Day: 25},
+		Observed: &date.Date{Year: 2021, Month: 12, Day: 27},
+	}
+	created, err := DefaultCreateHoliday(ctx, holiday, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadHoliday(ctx, &Holiday{Id: created.Id}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	holiday.Id = created.Id
+	if !proto.Equal(read, holiday) {
+		t.Errorf("Expected %v, got %v", holiday, read)
+	}
+}
@@ -0,0 +1,452 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	gorm "gorm.io/gorm"
+	time "time"
+)
+
+type RetentionORM struct {
+	Id     uint64
+	Ttl    *time.Duration
+	Window *time.Duration
+}
+
+// TableName overrides the default table name generated by GORM
+func (RetentionORM) TableName() string {
+	return "retentions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Retention) ToORM(ctx context.Context) (RetentionORM, error) {
+	to := RetentionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RetentionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Ttl != nil {
+		if !m.Ttl.IsValid() {
+			return to, fmt.Errorf("Ttl invalid")
+		}
+		d := m.Ttl.AsDuration()
+		to.Ttl = &d
+	}
+	if m.Window != nil {
+		if !m.Window.IsValid() {
+			return to, fmt.Errorf("Window invalid")
+		}
+		d := m.Window.AsDuration()
+		to.Window = &d
+	}
+	if posthook, ok := interface{}(m).(RetentionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RetentionORM) ToPB(ctx context.Context) (Retention, error) {
+	to := Retention{}
+	var err error
+	if prehook, ok := interface{}(m).(RetentionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Ttl != nil {
+		to.Ttl = durationpb.New(*m.Ttl)
+	}
+	if m.Window != nil {
+		to.Window = durationpb.New(*m.Window)
+	}
+	if posthook, ok := interface{}(m).(RetentionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Retention the arg will be the target, the caller the one being converted from
+
+// RetentionWithBeforeToORM called before default ToORM code
+type RetentionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RetentionORM) error
+}
+
+// RetentionWithAfterToORM called after default ToORM code
+type RetentionWithAfterToORM interface {
+	AfterToORM(context.Context, *RetentionORM) error
+}
+
+// RetentionWithBeforeToPB called before default ToPB code
+type RetentionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Retention) error
+}
+
+// RetentionWithAfterToPB called after default ToPB code
+type RetentionWithAfterToPB interface {
+	AfterToPB(context.Context, *Retention) error
+}
+
+// DefaultCreateRetention executes a basic gorm create call
+func DefaultCreateRetention(ctx context.Context, in *Retention, db *gorm.DB) (*Retention, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RetentionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadRetention executes a basic gorm read call
+func DefaultReadRetention(ctx context.Context, in *Retention, db *gorm.DB) (*Retention, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &RetentionORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RetentionORM{}
+	if err = db.Where(&RetentionORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RetentionORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RetentionORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRetention(ctx context.Context, in *Retention, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&RetentionORM{Id: ormObj.Id}).Delete(&RetentionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RetentionORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRetentionSet(ctx context.Context, in []*Retention, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&RetentionORM{})).(RetentionORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&RetentionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RetentionORM{})).(RetentionORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RetentionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Retention, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Retention, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRetention clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRetention(ctx context.Context, in *Retention, db *gorm.DB) (*Retention, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateRetention")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RetentionORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RetentionORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRetention executes a basic gorm update call with patch behavior
+func DefaultPatchRetention(ctx context.Context, in *Retention, updateMask *field_mask.FieldMask, db *gorm.DB) (*Retention, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Retention
+	var err error
+	if hook, ok := interface{}(&pbObj).(RetentionWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadRetention(ctx, &Retention{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(RetentionWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRetention(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RetentionWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRetention(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RetentionWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RetentionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Retention, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Retention, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Retention, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Retention, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRetention executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRetention(ctx context.Context, objects []*Retention, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Retention, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Retention, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRetention(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRetention patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRetention(ctx context.Context, patchee *Retention, patcher *Retention, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Retention, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Ttl" {
+			patchee.Ttl = patcher.Ttl
+			continue
+		}
+		if f == prefix+"Window" {
+			patchee.Window = patcher.Window
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRetention executes a gorm list call
+func DefaultListRetention(ctx context.Context, db *gorm.DB) ([]*Retention, error) {
+	in := Retention{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RetentionORM{}, &Retention{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []RetentionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RetentionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Retention{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RetentionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RetentionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RetentionORM) error
+}
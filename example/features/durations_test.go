@@ -0,0 +1,36 @@
+package features
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDurations(t *testing.T) {
+	db := openDB(t, &RetentionORM{})
+	ctx := context.Background()
+	retention, err := DefaultCreateRetention(ctx, &Retention{
+		Ttl:    durationpb.New(90 * time.Second),
+		Window: durationpb.New(-time.Millisecond),
+	}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadRetention(ctx, &Retention{Id: retention.Id}, db)
+	if err != nil || !proto.Equal(read, retention) {
+		t.Fatalf("Expected retention %v, got %v, %v", retention, read, err)
+	}
+	var ttl int64
+	if err := db.Table("retentions").Select("ttl").Where("id = ?", retention.Id).Scan(&ttl).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if ttl != int64(90*time.Second) {
+		t.Errorf("Expected the ttl stored in nanoseconds, got %d", ttl)
+	}
+	if _, err := DefaultCreateRetention(ctx, &Retention{Ttl: &durationpb.Duration{Seconds: 1, Nanos: -1}}, db); err == nil {
+		t.Errorf("Expected an error creating an invalid duration")
+	}
+}
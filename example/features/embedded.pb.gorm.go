@@ -0,0 +1,575 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	proto "google.golang.org/protobuf/proto"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type CustomerORM struct {
+	BillingCity    string     `gorm:"column:billing_town;size:64"`
+	BillingKind    int32      `gorm:"column:billing_kind"`
+	BillingNote    *string    `gorm:"column:billing_note"`
+	BillingSince   *time.Time `gorm:"column:billing_since"`
+	BillingStreet  string     `gorm:"column:billing_street"`
+	BillingZip     *int32     `gorm:"column:billing_zip"`
+	Id             uint64
+	ShippingCity   string     `gorm:"column:ship_town;size:64"`
+	ShippingKind   int32      `gorm:"column:ship_kind"`
+	ShippingNote   *string    `gorm:"column:ship_note"`
+	ShippingSince  *time.Time `gorm:"column:ship_since"`
+	ShippingStreet string     `gorm:"column:ship_street"`
+	ShippingZip    *int32     `gorm:"column:ship_zip"`
+}
+
+// TableName overrides the default table name generated by GORM
+func (CustomerORM) TableName() string {
+	return "customers"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Customer) ToORM(ctx context.Context) (CustomerORM, error) {
+	to := CustomerORM{}
+	var err error
+	if prehook, ok := interface{}(m).(CustomerWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Billing != nil {
+		to.BillingStreet = m.Billing.Street
+		to.BillingCity = m.Billing.City
+		if m.Billing.Zip != nil {
+			v := *m.Billing.Zip
+			to.BillingZip = &v
+		}
+		to.BillingKind = int32(m.Billing.Kind)
+		if m.Billing.Since != nil {
+			if !m.Billing.Since.IsValid() {
+				return to, fmt.Errorf("Billing.Since invalid")
+			}
+			t := m.Billing.Since.AsTime()
+			to.BillingSince = &t
+		}
+		if m.Billing.Note != nil {
+			v := m.Billing.Note.Value
+			to.BillingNote = &v
+		}
+	}
+	if m.Shipping != nil {
+		to.ShippingStreet = m.Shipping.Street
+		to.ShippingCity = m.Shipping.City
+		if m.Shipping.Zip != nil {
+			v := *m.Shipping.Zip
+			to.ShippingZip = &v
+		}
+		to.ShippingKind = int32(m.Shipping.Kind)
+		if m.Shipping.Since != nil {
+			if !m.Shipping.Since.IsValid() {
+				return to, fmt.Errorf("Shipping.Since invalid")
+			}
+			t := m.Shipping.Since.AsTime()
+			to.ShippingSince = &t
+		}
+		if m.Shipping.Note != nil {
+			v := m.Shipping.Note.Value
+			to.ShippingNote = &v
+		}
+	}
+	if posthook, ok := interface{}(m).(CustomerWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *CustomerORM) ToPB(ctx context.Context) (Customer, error) {
+	to := Customer{}
+	var err error
+	if prehook, ok := interface{}(m).(CustomerWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Billing = &Address{}
+	to.Billing.Street = m.BillingStreet
+	to.Billing.City = m.BillingCity
+	if m.BillingZip != nil {
+		v := *m.BillingZip
+		to.Billing.Zip = &v
+	}
+	to.Billing.Kind = Address_Kind(m.BillingKind)
+	if m.BillingSince != nil {
+		to.Billing.Since = timestamppb.New(*m.BillingSince)
+	}
+	if m.BillingNote != nil {
+		to.Billing.Note = &wrapperspb.StringValue{Value: *m.BillingNote}
+	}
+	if proto.Size(to.Billing) == 0 {
+		to.Billing = nil
+	}
+	to.Shipping = &Address{}
+	to.Shipping.Street = m.ShippingStreet
+	to.Shipping.City = m.ShippingCity
+	if m.ShippingZip != nil {
+		v := *m.ShippingZip
+		to.Shipping.Zip = &v
+	}
+	to.Shipping.Kind = Address_Kind(m.ShippingKind)
+	if m.ShippingSince != nil {
+		to.Shipping.Since = timestamppb.New(*m.ShippingSince)
+	}
+	if m.ShippingNote != nil {
+		to.Shipping.Note = &wrapperspb.StringValue{Value: *m.ShippingNote}
+	}
+	if proto.Size(to.Shipping) == 0 {
+		to.Shipping = nil
+	}
+	if posthook, ok := interface{}(m).(CustomerWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Customer the arg will be the target, the caller the one being converted from
+
+// CustomerWithBeforeToORM called before default ToORM code
+type CustomerWithBeforeToORM interface {
+	BeforeToORM(context.Context, *CustomerORM) error
+}
+
+// CustomerWithAfterToORM called after default ToORM code
+type CustomerWithAfterToORM interface {
+	AfterToORM(context.Context, *CustomerORM) error
+}
+
+// CustomerWithBeforeToPB called before default ToPB code
+type CustomerWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Customer) error
+}
+
+// CustomerWithAfterToPB called after default ToPB code
+type CustomerWithAfterToPB interface {
+	AfterToPB(context.Context, *Customer) error
+}
+
+// DefaultCreateCustomer executes a basic gorm create call
+func DefaultCreateCustomer(ctx context.Context, in *Customer, db *gorm.DB) (*Customer, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CustomerORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadCustomer executes a basic gorm read call
+func DefaultReadCustomer(ctx context.Context, in *Customer, db *gorm.DB) (*Customer, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &CustomerORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := CustomerORM{}
+	if err = db.Where(&CustomerORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(CustomerORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CustomerORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCustomer(ctx context.Context, in *Customer, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&CustomerORM{Id: ormObj.Id}).Delete(&CustomerORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type CustomerORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCustomerSet(ctx context.Context, in []*Customer, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&CustomerORM{})).(CustomerORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&CustomerORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&CustomerORM{})).(CustomerORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type CustomerORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Customer, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Customer, *gorm.DB) error
+}
+
+// DefaultStrictUpdateCustomer clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCustomer(ctx context.Context, in *Customer, db *gorm.DB) (*Customer, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateCustomer")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &CustomerORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type CustomerORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchCustomer executes a basic gorm update call with patch behavior
+func DefaultPatchCustomer(ctx context.Context, in *Customer, updateMask *field_mask.FieldMask, db *gorm.DB) (*Customer, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Customer
+	var err error
+	if hook, ok := interface{}(&pbObj).(CustomerWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadCustomer(ctx, &Customer{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(CustomerWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskCustomer(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(CustomerWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateCustomer(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(CustomerWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type CustomerWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Customer, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Customer, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Customer, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Customer, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetCustomer executes a bulk gorm update call with patch behavior
+func DefaultPatchSetCustomer(ctx context.Context, objects []*Customer, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Customer, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Customer, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchCustomer(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskCustomer patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCustomer(ctx context.Context, patchee *Customer, patcher *Customer, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Customer, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Billing" {
+			patchee.Billing = patcher.Billing
+			continue
+		}
+		if strings.HasPrefix(f, prefix+"Billing.") {
+			if patchee.Billing == nil {
+				patchee.Billing = &Address{}
+			}
+			source := patcher.Billing
+			if source == nil {
+				source = &Address{}
+			}
+			if f == prefix+"Billing.Street" {
+				patchee.Billing.Street = source.Street
+			}
+			if f == prefix+"Billing.City" {
+				patchee.Billing.City = source.City
+			}
+			if f == prefix+"Billing.Zip" {
+				patchee.Billing.Zip = source.Zip
+			}
+			if f == prefix+"Billing.Kind" {
+				patchee.Billing.Kind = source.Kind
+			}
+			if f == prefix+"Billing.Since" {
+				patchee.Billing.Since = source.Since
+			}
+			if f == prefix+"Billing.Note" {
+				patchee.Billing.Note = source.Note
+			}
+			continue
+		}
+		if f == prefix+"Shipping" {
+			patchee.Shipping = patcher.Shipping
+			continue
+		}
+		if strings.HasPrefix(f, prefix+"Shipping.") {
+			if patchee.Shipping == nil {
+				patchee.Shipping = &Address{}
+			}
+			source := patcher.Shipping
+			if source == nil {
+				source = &Address{}
+			}
+			if f == prefix+"Shipping.Street" {
+				patchee.Shipping.Street = source.Street
+			}
+			if f == prefix+"Shipping.City" {
+				patchee.Shipping.City = source.City
+			}
+			if f == prefix+"Shipping.Zip" {
+				patchee.Shipping.Zip = source.Zip
+			}
+			if f == prefix+"Shipping.Kind" {
+				patchee.Shipping.Kind = source.Kind
+			}
+			if f == prefix+"Shipping.Since" {
+				patchee.Shipping.Since = source.Since
+			}
+			if f == prefix+"Shipping.Note" {
+				patchee.Shipping.Note = source.Note
+			}
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListCustomer executes a gorm list call
+func DefaultListCustomer(ctx context.Context, db *gorm.DB) ([]*Customer, error) {
+	in := Customer{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CustomerORM{}, &Customer{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []CustomerORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CustomerORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Customer{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CustomerORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CustomerORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CustomerORM) error
+}
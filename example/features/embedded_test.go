@@ -0,0 +1,34 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestEmbedded(t *testing.T) {
+	db := openDB(t, &CustomerORM{})
+	ctx := context.Background()
+	zip := int32(0)
+	for _, customer := range []*Customer{
+		{},
+		{Billing: &Address{Street: "s", City: "c", Kind: Address_WORK, Note: wrapperspb.String("n")}},
+		{Shipping: &Address{Zip: &zip}},
+		{Shipping: &Address{Note: wrapperspb.String("")}},
+	} {
+		created, err := DefaultCreateCustomer(ctx, customer, db)
+		if err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+		read, err := DefaultReadCustomer(ctx, &Customer{Id: created.Id}, db)
+		if err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+		customer.Id = created.Id
+		if !proto.Equal(read, customer) {
+			t.Errorf("Expected %v, got %v", customer, read)
+		}
+	}
+}
@@ -0,0 +1,531 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	types "github.com/kirinse/protoc-gen-gorm/types"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	gorm "gorm.io/gorm"
+)
+
+type TicketORM struct {
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	Id        uint64
+	Native    string
+	Number    int32
+	Status    string
+}
+
+// TableName overrides the default table name generated by GORM
+func (TicketORM) TableName() string {
+	return "tickets"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Ticket) ToORM(ctx context.Context) (TicketORM, error) {
+	to := TicketORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TicketWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Status = Status_name[int32(m.Status)]
+	to.Native = Status_name[int32(m.Native)]
+	to.Number = int32(m.Number)
+	if posthook, ok := interface{}(m).(TicketWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TicketORM) ToPB(ctx context.Context) (Ticket, error) {
+	to := Ticket{}
+	var err error
+	if prehook, ok := interface{}(m).(TicketWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	tempStatus, ok := Status_value[m.Status]
+	if !ok && m.Status != "" {
+		return to, fmt.Errorf("unknown Status value %q", m.Status)
+	}
+	to.Status = Status(tempStatus)
+	tempNative, ok := Status_value[m.Native]
+	if !ok && m.Native != "" {
+		return to, fmt.Errorf("unknown Status value %q", m.Native)
+	}
+	to.Native = Status(tempNative)
+	to.Number = Status(m.Number)
+	if posthook, ok := interface{}(m).(TicketWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Ticket the arg will be the target, the caller the one being converted from
+
+// TicketWithBeforeToORM called before default ToORM code
+type TicketWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TicketORM) error
+}
+
+// TicketWithAfterToORM called after default ToORM code
+type TicketWithAfterToORM interface {
+	AfterToORM(context.Context, *TicketORM) error
+}
+
+// TicketWithBeforeToPB called before default ToPB code
+type TicketWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Ticket) error
+}
+
+// TicketWithAfterToPB called after default ToPB code
+type TicketWithAfterToPB interface {
+	AfterToPB(context.Context, *Ticket) error
+}
+
+// DefaultCreateTicket executes a basic gorm create call
+func DefaultCreateTicket(ctx context.Context, in *Ticket, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TicketORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadTicket executes a basic gorm read call
+func DefaultReadTicket(ctx context.Context, in *Ticket, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TicketORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TicketORM{}
+	if err = db.Where(&TicketORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TicketORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TicketORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTicket(ctx context.Context, in *Ticket, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&TicketORM{Id: ormObj.Id}).Delete(&TicketORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TicketORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTicketSet(ctx context.Context, in []*Ticket, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TicketORM{})).(TicketORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TicketORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TicketORM{})).(TicketORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TicketORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Ticket, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Ticket, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTicket clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTicket(ctx context.Context, in *Ticket, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateTicket")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &TicketORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TicketORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTicket executes a basic gorm update call with patch behavior
+func DefaultPatchTicket(ctx context.Context, in *Ticket, updateMask *field_mask.FieldMask, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Ticket
+	var err error
+	if hook, ok := interface{}(&pbObj).(TicketWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTicket(ctx, &Ticket{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TicketWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTicket(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TicketWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTicket(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TicketWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TicketWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TicketWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TicketWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TicketWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Ticket, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTicket executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTicket(ctx context.Context, objects []*Ticket, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Ticket, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Ticket, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTicket(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultRestoreTicket executes a gorm update call clearing the deleted at time of a soft deleted Ticket
+func DefaultRestoreTicket(ctx context.Context, in *Ticket, db *gorm.DB) (*Ticket, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeRestore); ok {
+		if db, err = hook.BeforeRestore(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Unscoped().Model(&TicketORM{}).Where(&TicketORM{Id: ormObj.Id}).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+	ormResponse := TicketORM{}
+	if err = db.Where(&TicketORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterRestore); ok {
+		if err = hook.AfterRestore(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TicketORMWithBeforeRestore interface {
+	BeforeRestore(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterRestore interface {
+	AfterRestore(context.Context, *gorm.DB) error
+}
+
+// DefaultHardDeleteTicket executes a gorm delete call removing the Ticket for good, deleted or not
+func DefaultHardDeleteTicket(ctx context.Context, in *Ticket, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeHardDelete); ok {
+		if db, err = hook.BeforeHardDelete(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Unscoped().Where(&TicketORM{Id: ormObj.Id}).Delete(&TicketORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterHardDelete); ok {
+		err = hook.AfterHardDelete(ctx, db)
+	}
+	return err
+}
+
+type TicketORMWithBeforeHardDelete interface {
+	BeforeHardDelete(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterHardDelete interface {
+	AfterHardDelete(context.Context, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskTicket patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTicket(ctx context.Context, patchee *Ticket, patcher *Ticket, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Ticket, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"Native" {
+			patchee.Native = patcher.Native
+			continue
+		}
+		if f == prefix+"Number" {
+			patchee.Number = patcher.Number
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTicket executes a gorm list call
+func DefaultListTicket(ctx context.Context, db *gorm.DB) ([]*Ticket, error) {
+	in := Ticket{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TicketORM{}, &Ticket{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	switch types.DeletedFilterFromContext(ctx) {
+	case types.IncludeDeleted:
+		db = db.Unscoped()
+	case types.OnlyDeleted:
+		db = db.Unscoped().Where("tickets.deleted_at IS NOT NULL")
+	}
+	db = db.Order("id")
+	ormResponse := []TicketORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TicketORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Ticket{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TicketORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TicketORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TicketORM) error
+}
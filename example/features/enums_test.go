@@ -0,0 +1,55 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEnumStorage(t *testing.T) {
+	db := openDB(t, &TicketORM{})
+	ctx := context.Background()
+	ticket := &Ticket{Status: Status_STATUS_OPEN, Native: Status_STATUS_CLOSED, Number: Status_STATUS_OPEN}
+	created, err := DefaultCreateTicket(ctx, ticket, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	var row struct {
+		Status string
+		Native string
+		Number int
+	}
+	if err := db.Raw("SELECT status, native, number FROM tickets").Scan(&row).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if row.Status != "STATUS_OPEN" || row.Native != "STATUS_CLOSED" || row.Number != 1 {
+		t.Errorf("Expected stored STATUS_OPEN, STATUS_CLOSED and 1, got %v", row)
+	}
+	// the zero enums of the key only messages are not conditions
+	read, err := DefaultReadTicket(ctx, &Ticket{Id: created.Id}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	ticket.Id = created.Id
+	if !proto.Equal(read, ticket) {
+		t.Errorf("Expected %v, got %v", ticket, read)
+	}
+	list, err := DefaultListTicket(ctx, db)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("Expected 1 ticket, got %v, %v", list, err)
+	}
+	if err := DefaultDeleteTicket(ctx, &Ticket{Id: created.Id}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if restored, err := DefaultRestoreTicket(ctx, &Ticket{Id: created.Id}, db); err != nil || !proto.Equal(restored, ticket) {
+		t.Fatalf("Expected restored %v, got %v, %v", ticket, restored, err)
+	}
+	if err := DefaultHardDeleteTicket(ctx, &Ticket{Id: created.Id}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	var count int64
+	if err := db.Unscoped().Model(&TicketORM{}).Count(&count).Error; err != nil || count != 0 {
+		t.Errorf("Expected no tickets, got %d, %v", count, err)
+	}
+}
@@ -0,0 +1,21 @@
+package features
+
+import (
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// openDB opens an in-memory SQLite database with the tables of the models
+func openDB(t *testing.T, models ...interface{}) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	return db
+}
@@ -0,0 +1,481 @@
+package features
+
+import (
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	types "github.com/kirinse/protoc-gen-gorm/types"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+)
+
+type HostORM struct {
+	Address *types.Inet `gorm:"type:text"`
+	Aliases datatypes.JSON
+	Id      uint64
+	Ports   datatypes.JSON
+	Weights datatypes.JSON
+}
+
+// TableName overrides the default table name generated by GORM
+func (HostORM) TableName() string {
+	return "hosts"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Host) ToORM(ctx context.Context) (HostORM, error) {
+	to := HostORM{}
+	var err error
+	if prehook, ok := interface{}(m).(HostWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Aliases != nil {
+		if to.Aliases, err = json.Marshal(m.Aliases); err != nil {
+			return to, err
+		}
+	}
+	if m.Ports != nil {
+		if to.Ports, err = json.Marshal(m.Ports); err != nil {
+			return to, err
+		}
+	}
+	if m.Weights != nil {
+		if to.Weights, err = json.Marshal(m.Weights); err != nil {
+			return to, err
+		}
+	}
+	if m.Address != nil {
+		if to.Address, err = types.ParseInet(m.Address.Value); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(HostWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *HostORM) ToPB(ctx context.Context) (Host, error) {
+	to := Host{}
+	var err error
+	if prehook, ok := interface{}(m).(HostWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if len(m.Aliases) > 0 {
+		if err = json.Unmarshal(m.Aliases, &to.Aliases); err != nil {
+			return to, err
+		}
+	}
+	if len(m.Ports) > 0 {
+		if err = json.Unmarshal(m.Ports, &to.Ports); err != nil {
+			return to, err
+		}
+	}
+	if len(m.Weights) > 0 {
+		if err = json.Unmarshal(m.Weights, &to.Weights); err != nil {
+			return to, err
+		}
+	}
+	if m.Address != nil && m.Address.IPNet != nil {
+		to.Address = &types.InetValue{Value: m.Address.String()}
+	}
+	if posthook, ok := interface{}(m).(HostWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Host the arg will be the target, the caller the one being converted from
+
+// HostWithBeforeToORM called before default ToORM code
+type HostWithBeforeToORM interface {
+	BeforeToORM(context.Context, *HostORM) error
+}
+
+// HostWithAfterToORM called after default ToORM code
+type HostWithAfterToORM interface {
+	AfterToORM(context.Context, *HostORM) error
+}
+
+// HostWithBeforeToPB called before default ToPB code
+type HostWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Host) error
+}
+
+// HostWithAfterToPB called after default ToPB code
+type HostWithAfterToPB interface {
+	AfterToPB(context.Context, *Host) error
+}
+
+// DefaultCreateHost executes a basic gorm create call
+func DefaultCreateHost(ctx context.Context, in *Host, db *gorm.DB) (*Host, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type HostORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadHost executes a basic gorm read call
+func DefaultReadHost(ctx context.Context, in *Host, db *gorm.DB) (*Host, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &HostORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := HostORM{}
+	if err = db.Where(&HostORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(HostORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type HostORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteHost(ctx context.Context, in *Host, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&HostORM{Id: ormObj.Id}).Delete(&HostORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type HostORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteHostSet(ctx context.Context, in []*Host, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&HostORM{})).(HostORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&HostORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&HostORM{})).(HostORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type HostORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Host, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Host, *gorm.DB) error
+}
+
+// DefaultStrictUpdateHost clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateHost(ctx context.Context, in *Host, db *gorm.DB) (*Host, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateHost")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &HostORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type HostORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchHost executes a basic gorm update call with patch behavior
+func DefaultPatchHost(ctx context.Context, in *Host, updateMask *field_mask.FieldMask, db *gorm.DB) (*Host, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Host
+	var err error
+	if hook, ok := interface{}(&pbObj).(HostWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadHost(ctx, &Host{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(HostWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskHost(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(HostWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateHost(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(HostWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type HostWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Host, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type HostWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Host, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type HostWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Host, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type HostWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Host, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetHost executes a bulk gorm update call with patch behavior
+func DefaultPatchSetHost(ctx context.Context, objects []*Host, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Host, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Host, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchHost(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskHost patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskHost(ctx context.Context, patchee *Host, patcher *Host, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Host, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Aliases" {
+			patchee.Aliases = patcher.Aliases
+			continue
+		}
+		if f == prefix+"Ports" {
+			patchee.Ports = patcher.Ports
+			continue
+		}
+		if f == prefix+"Weights" {
+			patchee.Weights = patcher.Weights
+			continue
+		}
+		if f == prefix+"Address" {
+			patchee.Address = patcher.Address
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListHost executes a gorm list call
+func DefaultListHost(ctx context.Context, db *gorm.DB) ([]*Host, error) {
+	in := Host{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &HostORM{}, &Host{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []HostORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(HostORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Host{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type HostORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type HostORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]HostORM) error
+}
@@ -0,0 +1,45 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirinse/protoc-gen-gorm/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDialectArrays(t *testing.T) {
+	db := openDB(t, &HostORM{})
+	ctx := context.Background()
+	host, err := DefaultCreateHost(ctx, &Host{
+		Aliases: []string{"a", "b"},
+		Ports:   []int64{80, 443},
+		Weights: []float64{0.5},
+		Address: &types.InetValue{Value: "10.0.0.1/24"},
+	}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadHost(ctx, &Host{Id: host.Id}, db)
+	if err != nil || !proto.Equal(read, host) {
+		t.Fatalf("Expected host %v, got %v, %v", host, read, err)
+	}
+	var row struct {
+		Aliases string
+		Ports   string
+		Address string
+	}
+	if err := db.Table("hosts").Where("id = ?", host.Id).Scan(&row).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if row.Aliases != `["a","b"]` || row.Ports != `[80,443]` || row.Address != "10.0.0.1/24" {
+		t.Errorf("Unexpected columns of the host: %+v", row)
+	}
+	host.Aliases = nil
+	if _, err := DefaultStrictUpdateHost(ctx, host, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if read, err = DefaultReadHost(ctx, &Host{Id: host.Id}, db); err != nil || len(read.Aliases) != 0 || len(read.Ports) != 2 {
+		t.Errorf("Expected host without aliases, got %v, %v", read, err)
+	}
+}
@@ -0,0 +1,32 @@
+package features
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestIndexes(t *testing.T) {
+	db := openDB(t, &EventORM{})
+	ctx := context.Background()
+	for name, columns := range map[string]string{
+		"idx_events_tenant_id_started_at": "(`tenant_id`,`started_at` desc)",
+		"idx_events_email":                "(lower(email)) WHERE email <> ''",
+	} {
+		var sql string
+		if err := db.Raw("SELECT sql FROM sqlite_master WHERE type = 'index' AND name = ?", name).Scan(&sql).Error; err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+		if !strings.HasSuffix(sql, columns) {
+			t.Errorf("Expected index %s on %s, got %q", name, columns, sql)
+		}
+	}
+	for _, email := range []string{"ann@example.com", "", ""} {
+		if _, err := DefaultCreateEvent(ctx, &Event{TenantId: "t", Email: email}, db); err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+	}
+	if _, err := DefaultCreateEvent(ctx, &Event{TenantId: "t", Email: "Ann@Example.com"}, db); err == nil {
+		t.Errorf("Expected an error creating an event of a duplicate email")
+	}
+}
@@ -0,0 +1,458 @@
+package features
+
+import (
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+)
+
+type ServerORM struct {
+	Id    uint64
+	Ports datatypes.JSON
+}
+
+// TableName overrides the default table name generated by GORM
+func (ServerORM) TableName() string {
+	return "servers"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Server) ToORM(ctx context.Context) (ServerORM, error) {
+	to := ServerORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ServerWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Ports != nil {
+		tempPorts := make([]json.RawMessage, len(m.Ports))
+		for i, v := range m.Ports {
+			if tempPorts[i], err = protojson.Marshal(v); err != nil {
+				return to, err
+			}
+		}
+		if to.Ports, err = json.Marshal(tempPorts); err != nil {
+			return to, err
+		}
+	}
+	// Repeated type Port is not an ORMable message type
+	if posthook, ok := interface{}(m).(ServerWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ServerORM) ToPB(ctx context.Context) (Server, error) {
+	to := Server{}
+	var err error
+	if prehook, ok := interface{}(m).(ServerWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if len(m.Ports) > 0 {
+		var tempPorts []json.RawMessage
+		if err = json.Unmarshal(m.Ports, &tempPorts); err != nil {
+			return to, err
+		}
+		to.Ports = make([]*Port, len(tempPorts))
+		for i, v := range tempPorts {
+			to.Ports[i] = &Port{}
+			if err = protojson.Unmarshal(v, to.Ports[i]); err != nil {
+				return to, err
+			}
+		}
+	}
+	// Repeated type Port is not an ORMable message type
+	if posthook, ok := interface{}(m).(ServerWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Server the arg will be the target, the caller the one being converted from
+
+// ServerWithBeforeToORM called before default ToORM code
+type ServerWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ServerORM) error
+}
+
+// ServerWithAfterToORM called after default ToORM code
+type ServerWithAfterToORM interface {
+	AfterToORM(context.Context, *ServerORM) error
+}
+
+// ServerWithBeforeToPB called before default ToPB code
+type ServerWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Server) error
+}
+
+// ServerWithAfterToPB called after default ToPB code
+type ServerWithAfterToPB interface {
+	AfterToPB(context.Context, *Server) error
+}
+
+// DefaultCreateServer executes a basic gorm create call
+func DefaultCreateServer(ctx context.Context, in *Server, db *gorm.DB) (*Server, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ServerORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadServer executes a basic gorm read call
+func DefaultReadServer(ctx context.Context, in *Server, db *gorm.DB) (*Server, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ServerORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ServerORM{}
+	if err = db.Where(&ServerORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ServerORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ServerORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteServer(ctx context.Context, in *Server, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ServerORM{Id: ormObj.Id}).Delete(&ServerORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ServerORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteServerSet(ctx context.Context, in []*Server, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ServerORM{})).(ServerORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ServerORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ServerORM{})).(ServerORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ServerORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Server, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Server, *gorm.DB) error
+}
+
+// DefaultStrictUpdateServer clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateServer(ctx context.Context, in *Server, db *gorm.DB) (*Server, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateServer")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ServerORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ServerORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchServer executes a basic gorm update call with patch behavior
+func DefaultPatchServer(ctx context.Context, in *Server, updateMask *field_mask.FieldMask, db *gorm.DB) (*Server, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Server
+	var err error
+	if hook, ok := interface{}(&pbObj).(ServerWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadServer(ctx, &Server{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ServerWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskServer(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ServerWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateServer(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ServerWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ServerWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Server, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ServerWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Server, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ServerWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Server, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ServerWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Server, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetServer executes a bulk gorm update call with patch behavior
+func DefaultPatchSetServer(ctx context.Context, objects []*Server, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Server, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Server, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchServer(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskServer patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskServer(ctx context.Context, patchee *Server, patcher *Server, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Server, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Ports" {
+			patchee.Ports = patcher.Ports
+			continue
+		}
+		if f == prefix+"Probes" {
+			patchee.Probes = patcher.Probes
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListServer executes a gorm list call
+func DefaultListServer(ctx context.Context, db *gorm.DB) ([]*Server, error) {
+	in := Server{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ServerORM{}, &Server{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []ServerORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ServerORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Server{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ServerORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ServerORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ServerORM) error
+}
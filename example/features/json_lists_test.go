@@ -0,0 +1,38 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestJSONLists(t *testing.T) {
+	db := openDB(t, &ServerORM{})
+	ctx := context.Background()
+	server, err := DefaultCreateServer(ctx, &Server{
+		Ports:  []*Port{{Number: 80, Protocol: "tcp"}, {Number: 53, Protocol: "udp"}},
+		Probes: []*Port{{Number: 8080}},
+	}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadServer(ctx, &Server{Id: server.Id}, db)
+	expected := &Server{Id: server.Id, Ports: server.Ports}
+	if err != nil || !proto.Equal(read, expected) {
+		t.Fatalf("Expected server %v without probes, got %v, %v", expected, read, err)
+	}
+	var ports string
+	if err := db.Table("servers").Select("ports").Where("id = ?", server.Id).Scan(&ports).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if ports != `[{"number":80,"proto":"tcp"},{"number":53,"proto":"udp"}]` {
+		t.Errorf("Expected ports keyed by json_name, got %s", ports)
+	}
+	if err := db.Table("servers").Where("id = ?", server.Id).Update("ports", `[{"number":80,"transport":"tcp"}]`).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if _, err := DefaultReadServer(ctx, &Server{Id: server.Id}, db); err == nil {
+		t.Errorf("Expected an error reading ports stored under a former field name")
+	}
+}
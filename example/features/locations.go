@@ -0,0 +1,31 @@
+package features
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/type/latlng"
+)
+
+// LatLngToText converts a LatLng to the "lat,lng" text of its column
+func LatLngToText(l *latlng.LatLng) (*string, error) {
+	if l == nil {
+		return nil, nil
+	}
+	if l.Latitude < -90 || l.Latitude > 90 || l.Longitude < -180 || l.Longitude > 180 {
+		return nil, fmt.Errorf("invalid LatLng %v", l)
+	}
+	text := fmt.Sprintf("%g,%g", l.Latitude, l.Longitude)
+	return &text, nil
+}
+
+// TextToLatLng converts the "lat,lng" text of a column back to a LatLng
+func TextToLatLng(text *string) (*latlng.LatLng, error) {
+	if text == nil {
+		return nil, nil
+	}
+	l := &latlng.LatLng{}
+	if _, err := fmt.Sscanf(*text, "%g,%g", &l.Latitude, &l.Longitude); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
@@ -0,0 +1,438 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	gorm "gorm.io/gorm"
+)
+
+type SiteORM struct {
+	Id       uint64
+	Location *string `gorm:"type:text"`
+	Name     string
+}
+
+// TableName overrides the default table name generated by GORM
+func (SiteORM) TableName() string {
+	return "sites"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Site) ToORM(ctx context.Context) (SiteORM, error) {
+	to := SiteORM{}
+	var err error
+	if prehook, ok := interface{}(m).(SiteWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if to.Location, err = LatLngToText(m.Location); err != nil {
+		return to, err
+	}
+	if posthook, ok := interface{}(m).(SiteWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *SiteORM) ToPB(ctx context.Context) (Site, error) {
+	to := Site{}
+	var err error
+	if prehook, ok := interface{}(m).(SiteWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if to.Location, err = TextToLatLng(m.Location); err != nil {
+		return to, err
+	}
+	if posthook, ok := interface{}(m).(SiteWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Site the arg will be the target, the caller the one being converted from
+
+// SiteWithBeforeToORM called before default ToORM code
+type SiteWithBeforeToORM interface {
+	BeforeToORM(context.Context, *SiteORM) error
+}
+
+// SiteWithAfterToORM called after default ToORM code
+type SiteWithAfterToORM interface {
+	AfterToORM(context.Context, *SiteORM) error
+}
+
+// SiteWithBeforeToPB called before default ToPB code
+type SiteWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Site) error
+}
+
+// SiteWithAfterToPB called after default ToPB code
+type SiteWithAfterToPB interface {
+	AfterToPB(context.Context, *Site) error
+}
+
+// DefaultCreateSite executes a basic gorm create call
+func DefaultCreateSite(ctx context.Context, in *Site, db *gorm.DB) (*Site, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SiteORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadSite executes a basic gorm read call
+func DefaultReadSite(ctx context.Context, in *Site, db *gorm.DB) (*Site, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &SiteORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SiteORM{}
+	if err = db.Where(&SiteORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SiteORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SiteORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSite(ctx context.Context, in *Site, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&SiteORM{Id: ormObj.Id}).Delete(&SiteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type SiteORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSiteSet(ctx context.Context, in []*Site, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&SiteORM{})).(SiteORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&SiteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&SiteORM{})).(SiteORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type SiteORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Site, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Site, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSite clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSite(ctx context.Context, in *Site, db *gorm.DB) (*Site, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateSite")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &SiteORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type SiteORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSite executes a basic gorm update call with patch behavior
+func DefaultPatchSite(ctx context.Context, in *Site, updateMask *field_mask.FieldMask, db *gorm.DB) (*Site, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Site
+	var err error
+	if hook, ok := interface{}(&pbObj).(SiteWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadSite(ctx, &Site{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(SiteWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSite(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(SiteWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSite(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(SiteWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type SiteWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Site, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SiteWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Site, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SiteWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Site, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SiteWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Site, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSite executes a bulk gorm update call with patch behavior
+func DefaultPatchSetSite(ctx context.Context, objects []*Site, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Site, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Site, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchSite(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSite patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSite(ctx context.Context, patchee *Site, patcher *Site, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Site, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Location" {
+			patchee.Location = patcher.Location
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSite executes a gorm list call
+func DefaultListSite(ctx context.Context, db *gorm.DB) ([]*Site, error) {
+	in := Site{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &SiteORM{}, &Site{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []SiteORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SiteORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Site{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SiteORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SiteORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SiteORM) error
+}
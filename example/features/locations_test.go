@@ -0,0 +1,34 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTypeMappings(t *testing.T) {
+	db := openDB(t, &SiteORM{})
+	ctx := context.Background()
+	for _, site := range []*Site{
+		{Name: "a", Location: &latlng.LatLng{Latitude: 52.52, Longitude: -13.405}},
+		{Name: "b"},
+	} {
+		created, err := DefaultCreateSite(ctx, site, db)
+		if err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+		read, err := DefaultReadSite(ctx, &Site{Id: created.Id}, db)
+		if err != nil || !proto.Equal(read, created) {
+			t.Errorf("Expected site %v, got %v, %v", created, read, err)
+		}
+	}
+	var location string
+	if err := db.Table("sites").Select("location").Where("name = ?", "a").Scan(&location).Error; err != nil || location != "52.52,-13.405" {
+		t.Errorf("Expected location 52.52,-13.405, got %s, %v", location, err)
+	}
+	if _, err := DefaultCreateSite(ctx, &Site{Location: &latlng.LatLng{Latitude: 91}}, db); err == nil {
+		t.Errorf("Expected an error creating a site of an invalid location")
+	}
+}
@@ -0,0 +1,534 @@
+package features
+
+import (
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+	strings "strings"
+)
+
+type ProjectORM struct {
+	Counters datatypes.JSON
+	Id       uint64
+	Labels   datatypes.JSON
+	Owners   datatypes.JSON
+	Quotas   datatypes.JSON
+}
+
+// TableName overrides the default table name generated by GORM
+func (ProjectORM) TableName() string {
+	return "projects"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Project) ToORM(ctx context.Context) (ProjectORM, error) {
+	to := ProjectORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ProjectWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Labels != nil {
+		if to.Labels, err = json.Marshal(m.Labels); err != nil {
+			return to, err
+		}
+	}
+	if m.Counters != nil {
+		if to.Counters, err = json.Marshal(m.Counters); err != nil {
+			return to, err
+		}
+	}
+	if m.Quotas != nil {
+		tempQuotas := make(map[string]json.RawMessage, len(m.Quotas))
+		for k, v := range m.Quotas {
+			if tempQuotas[k], err = protojson.Marshal(v); err != nil {
+				return to, err
+			}
+		}
+		if to.Quotas, err = json.Marshal(tempQuotas); err != nil {
+			return to, err
+		}
+	}
+	if m.Owners != nil {
+		if to.Owners, err = json.Marshal(m.Owners); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(ProjectWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ProjectORM) ToPB(ctx context.Context) (Project, error) {
+	to := Project{}
+	var err error
+	if prehook, ok := interface{}(m).(ProjectWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if len(m.Labels) > 0 {
+		if err = json.Unmarshal(m.Labels, &to.Labels); err != nil {
+			return to, err
+		}
+	}
+	if len(m.Counters) > 0 {
+		if err = json.Unmarshal(m.Counters, &to.Counters); err != nil {
+			return to, err
+		}
+	}
+	if len(m.Quotas) > 0 {
+		var tempQuotas map[string]json.RawMessage
+		if err = json.Unmarshal(m.Quotas, &tempQuotas); err != nil {
+			return to, err
+		}
+		to.Quotas = make(map[string]*Quota, len(tempQuotas))
+		for k, v := range tempQuotas {
+			to.Quotas[k] = &Quota{}
+			if err = protojson.Unmarshal(v, to.Quotas[k]); err != nil {
+				return to, err
+			}
+		}
+	}
+	if len(m.Owners) > 0 {
+		if err = json.Unmarshal(m.Owners, &to.Owners); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(ProjectWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Project the arg will be the target, the caller the one being converted from
+
+// ProjectWithBeforeToORM called before default ToORM code
+type ProjectWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ProjectORM) error
+}
+
+// ProjectWithAfterToORM called after default ToORM code
+type ProjectWithAfterToORM interface {
+	AfterToORM(context.Context, *ProjectORM) error
+}
+
+// ProjectWithBeforeToPB called before default ToPB code
+type ProjectWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Project) error
+}
+
+// ProjectWithAfterToPB called after default ToPB code
+type ProjectWithAfterToPB interface {
+	AfterToPB(context.Context, *Project) error
+}
+
+// DefaultCreateProject executes a basic gorm create call
+func DefaultCreateProject(ctx context.Context, in *Project, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadProject executes a basic gorm read call
+func DefaultReadProject(ctx context.Context, in *Project, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ProjectORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ProjectORM{}
+	if err = db.Where(&ProjectORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ProjectORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteProject(ctx context.Context, in *Project, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ProjectORM{Id: ormObj.Id}).Delete(&ProjectORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ProjectORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteProjectSet(ctx context.Context, in []*Project, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ProjectORM{})).(ProjectORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ProjectORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ProjectORM{})).(ProjectORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ProjectORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Project, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Project, *gorm.DB) error
+}
+
+// DefaultStrictUpdateProject clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateProject(ctx context.Context, in *Project, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateProject")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ProjectORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ProjectORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchProject executes a basic gorm update call with patch behavior
+func DefaultPatchProject(ctx context.Context, in *Project, updateMask *field_mask.FieldMask, db *gorm.DB) (*Project, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Project
+	var err error
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadProject(ctx, &Project{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskProject(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ProjectWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateProject(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ProjectWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ProjectWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Project, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetProject executes a bulk gorm update call with patch behavior
+func DefaultPatchSetProject(ctx context.Context, objects []*Project, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Project, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Project, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchProject(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskProject patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskProject(ctx context.Context, patchee *Project, patcher *Project, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Project, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Labels" {
+			patchee.Labels = patcher.Labels
+			continue
+		}
+		if strings.HasPrefix(f, prefix+"Labels.") {
+			key := strings.TrimPrefix(f, prefix+"Labels.")
+			if v, ok := patcher.Labels[key]; ok {
+				if patchee.Labels == nil {
+					patchee.Labels = make(map[string]string)
+				}
+				patchee.Labels[key] = v
+			} else {
+				delete(patchee.Labels, key)
+			}
+			continue
+		}
+		if f == prefix+"Counters" {
+			patchee.Counters = patcher.Counters
+			continue
+		}
+		if strings.HasPrefix(f, prefix+"Counters.") {
+			key := strings.TrimPrefix(f, prefix+"Counters.")
+			if v, ok := patcher.Counters[key]; ok {
+				if patchee.Counters == nil {
+					patchee.Counters = make(map[string]int64)
+				}
+				patchee.Counters[key] = v
+			} else {
+				delete(patchee.Counters, key)
+			}
+			continue
+		}
+		if f == prefix+"Quotas" {
+			patchee.Quotas = patcher.Quotas
+			continue
+		}
+		if strings.HasPrefix(f, prefix+"Quotas.") {
+			key := strings.TrimPrefix(f, prefix+"Quotas.")
+			if v, ok := patcher.Quotas[key]; ok {
+				if patchee.Quotas == nil {
+					patchee.Quotas = make(map[string]*Quota)
+				}
+				patchee.Quotas[key] = v
+			} else {
+				delete(patchee.Quotas, key)
+			}
+			continue
+		}
+		if f == prefix+"Owners" {
+			patchee.Owners = patcher.Owners
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListProject executes a gorm list call
+func DefaultListProject(ctx context.Context, db *gorm.DB) ([]*Project, error) {
+	in := Project{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ProjectORM{}, &Project{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []ProjectORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ProjectORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Project{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ProjectORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ProjectORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ProjectORM) error
+}
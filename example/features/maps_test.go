@@ -0,0 +1,49 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMaps(t *testing.T) {
+	db := openDB(t, &ProjectORM{})
+	ctx := context.Background()
+	project, err := DefaultCreateProject(ctx, &Project{
+		Labels:   map[string]string{"env": "prod", "team": "core"},
+		Counters: map[string]int64{"hosts": 1 << 40},
+		Quotas:   map[string]*Quota{"cpu": {Limit: 8, Unit: "cores"}},
+		Owners:   map[int32]string{7: "ann"},
+	}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadProject(ctx, &Project{Id: project.Id}, db)
+	if err != nil || !proto.Equal(read, project) {
+		t.Fatalf("Expected project %v, got %v, %v", project, read, err)
+	}
+	var labels string
+	if err := db.Table("projects").Select("labels").Where("id = ?", project.Id).Scan(&labels).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if labels != `{"env":"prod","team":"core"}` {
+		t.Errorf("Unexpected labels column: %s", labels)
+	}
+
+	mask := &field_mask.FieldMask{Paths: []string{"Labels.env", "Labels.team", "Counters"}}
+	patched, err := DefaultPatchProject(ctx, &Project{Id: project.Id, Labels: map[string]string{"env": "dev"}}, mask, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	expected := &Project{
+		Id:     project.Id,
+		Labels: map[string]string{"env": "dev"},
+		Quotas: project.Quotas,
+		Owners: project.Owners,
+	}
+	if read, err = DefaultReadProject(ctx, &Project{Id: project.Id}, db); err != nil || !proto.Equal(read, expected) || !proto.Equal(patched, expected) {
+		t.Errorf("Expected patched project %v, got %v and %v, %v", expected, patched, read, err)
+	}
+}
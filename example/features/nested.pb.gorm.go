@@ -0,0 +1,920 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	gorm "gorm.io/gorm"
+	strings "strings"
+)
+
+type CatalogORM struct {
+	Catalog_ItemId *uint64
+	Featured       *Catalog_ItemORM `gorm:"foreignKey:Catalog_ItemId;references:Id"`
+	Id             uint64
+	Items          []*Catalog_ItemORM `gorm:"foreignKey:CatalogId;references:Id"`
+	Name           string
+}
+
+// TableName overrides the default table name generated by GORM
+func (CatalogORM) TableName() string {
+	return "catalogs"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Catalog) ToORM(ctx context.Context) (CatalogORM, error) {
+	to := CatalogORM{}
+	var err error
+	if prehook, ok := interface{}(m).(CatalogWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Items {
+		if v != nil {
+			if tempItems, cErr := v.ToORM(ctx); cErr == nil {
+				to.Items = append(to.Items, &tempItems)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Items = append(to.Items, nil)
+		}
+	}
+	if m.Featured != nil {
+		tempFeatured, err := m.Featured.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Featured = &tempFeatured
+	}
+	if posthook, ok := interface{}(m).(CatalogWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *CatalogORM) ToPB(ctx context.Context) (Catalog, error) {
+	to := Catalog{}
+	var err error
+	if prehook, ok := interface{}(m).(CatalogWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Items {
+		if v != nil {
+			if tempItems, cErr := v.ToPB(ctx); cErr == nil {
+				to.Items = append(to.Items, &tempItems)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Items = append(to.Items, nil)
+		}
+	}
+	if m.Featured != nil {
+		tempFeatured, err := m.Featured.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Featured = &tempFeatured
+	}
+	if posthook, ok := interface{}(m).(CatalogWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Catalog the arg will be the target, the caller the one being converted from
+
+// CatalogWithBeforeToORM called before default ToORM code
+type CatalogWithBeforeToORM interface {
+	BeforeToORM(context.Context, *CatalogORM) error
+}
+
+// CatalogWithAfterToORM called after default ToORM code
+type CatalogWithAfterToORM interface {
+	AfterToORM(context.Context, *CatalogORM) error
+}
+
+// CatalogWithBeforeToPB called before default ToPB code
+type CatalogWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Catalog) error
+}
+
+// CatalogWithAfterToPB called after default ToPB code
+type CatalogWithAfterToPB interface {
+	AfterToPB(context.Context, *Catalog) error
+}
+
+type Catalog_ItemORM struct {
+	CatalogId *uint64
+	Id        uint64
+	Sku       string
+}
+
+// TableName overrides the default table name generated by GORM
+func (Catalog_ItemORM) TableName() string {
+	return "catalog_items"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Catalog_Item) ToORM(ctx context.Context) (Catalog_ItemORM, error) {
+	to := Catalog_ItemORM{}
+	var err error
+	if prehook, ok := interface{}(m).(Catalog_ItemWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Sku = m.Sku
+	if posthook, ok := interface{}(m).(Catalog_ItemWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *Catalog_ItemORM) ToPB(ctx context.Context) (Catalog_Item, error) {
+	to := Catalog_Item{}
+	var err error
+	if prehook, ok := interface{}(m).(Catalog_ItemWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Sku = m.Sku
+	if posthook, ok := interface{}(m).(Catalog_ItemWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Catalog_Item the arg will be the target, the caller the one being converted from
+
+// Catalog_ItemWithBeforeToORM called before default ToORM code
+type Catalog_ItemWithBeforeToORM interface {
+	BeforeToORM(context.Context, *Catalog_ItemORM) error
+}
+
+// Catalog_ItemWithAfterToORM called after default ToORM code
+type Catalog_ItemWithAfterToORM interface {
+	AfterToORM(context.Context, *Catalog_ItemORM) error
+}
+
+// Catalog_ItemWithBeforeToPB called before default ToPB code
+type Catalog_ItemWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Catalog_Item) error
+}
+
+// Catalog_ItemWithAfterToPB called after default ToPB code
+type Catalog_ItemWithAfterToPB interface {
+	AfterToPB(context.Context, *Catalog_Item) error
+}
+
+// DefaultCreateCatalog executes a basic gorm create call
+func DefaultCreateCatalog(ctx context.Context, in *Catalog, db *gorm.DB) (*Catalog, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CatalogORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadCatalog executes a basic gorm read call
+func DefaultReadCatalog(ctx context.Context, in *Catalog, db *gorm.DB) (*Catalog, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &CatalogORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := CatalogORM{}
+	if err = db.Where(&CatalogORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(CatalogORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CatalogORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCatalog(ctx context.Context, in *Catalog, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&CatalogORM{Id: ormObj.Id}).Delete(&CatalogORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type CatalogORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCatalogSet(ctx context.Context, in []*Catalog, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&CatalogORM{})).(CatalogORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&CatalogORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&CatalogORM{})).(CatalogORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type CatalogORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Catalog, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Catalog, *gorm.DB) error
+}
+
+// DefaultStrictUpdateCatalog clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCatalog(ctx context.Context, in *Catalog, db *gorm.DB) (*Catalog, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateCatalog")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &CatalogORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterItems := Catalog_ItemORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterItems.CatalogId = new(uint64)
+	*filterItems.CatalogId = ormObj.Id
+	if err = db.Where(filterItems).Delete(Catalog_ItemORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type CatalogORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchCatalog executes a basic gorm update call with patch behavior
+func DefaultPatchCatalog(ctx context.Context, in *Catalog, updateMask *field_mask.FieldMask, db *gorm.DB) (*Catalog, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Catalog
+	var err error
+	if hook, ok := interface{}(&pbObj).(CatalogWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadCatalog(ctx, &Catalog{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(CatalogWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskCatalog(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(CatalogWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateCatalog(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(CatalogWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type CatalogWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Catalog, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Catalog, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Catalog, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Catalog, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetCatalog executes a bulk gorm update call with patch behavior
+func DefaultPatchSetCatalog(ctx context.Context, objects []*Catalog, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Catalog, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Catalog, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchCatalog(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskCatalog patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCatalog(ctx context.Context, patchee *Catalog, patcher *Catalog, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Catalog, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedFeatured bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Items" {
+			patchee.Items = patcher.Items
+			continue
+		}
+		if !updatedFeatured && strings.HasPrefix(f, prefix+"Featured.") {
+			updatedFeatured = true
+			if patcher.Featured == nil {
+				patchee.Featured = nil
+				continue
+			}
+			if patchee.Featured == nil {
+				patchee.Featured = &Catalog_Item{}
+			}
+			if o, err := DefaultApplyFieldMaskCatalog_Item(ctx, patchee.Featured, patcher.Featured, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Featured.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Featured = o
+			}
+			continue
+		}
+		if f == prefix+"Featured" {
+			updatedFeatured = true
+			patchee.Featured = patcher.Featured
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListCatalog executes a gorm list call
+func DefaultListCatalog(ctx context.Context, db *gorm.DB) ([]*Catalog, error) {
+	in := Catalog{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CatalogORM{}, &Catalog{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []CatalogORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CatalogORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Catalog{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CatalogORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CatalogORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CatalogORM) error
+}
+
+// DefaultCreateCatalog_Item executes a basic gorm create call
+func DefaultCreateCatalog_Item(ctx context.Context, in *Catalog_Item, db *gorm.DB) (*Catalog_Item, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type Catalog_ItemORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadCatalog_Item executes a basic gorm read call
+func DefaultReadCatalog_Item(ctx context.Context, in *Catalog_Item, db *gorm.DB) (*Catalog_Item, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &Catalog_ItemORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := Catalog_ItemORM{}
+	if err = db.Where(&Catalog_ItemORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(Catalog_ItemORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type Catalog_ItemORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCatalog_Item(ctx context.Context, in *Catalog_Item, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&Catalog_ItemORM{Id: ormObj.Id}).Delete(&Catalog_ItemORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type Catalog_ItemORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteCatalog_ItemSet(ctx context.Context, in []*Catalog_Item, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&Catalog_ItemORM{})).(Catalog_ItemORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&Catalog_ItemORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&Catalog_ItemORM{})).(Catalog_ItemORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type Catalog_ItemORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Catalog_Item, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Catalog_Item, *gorm.DB) error
+}
+
+// DefaultStrictUpdateCatalog_Item clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCatalog_Item(ctx context.Context, in *Catalog_Item, db *gorm.DB) (*Catalog_Item, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateCatalog_Item")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &Catalog_ItemORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type Catalog_ItemORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchCatalog_Item executes a basic gorm update call with patch behavior
+func DefaultPatchCatalog_Item(ctx context.Context, in *Catalog_Item, updateMask *field_mask.FieldMask, db *gorm.DB) (*Catalog_Item, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Catalog_Item
+	var err error
+	if hook, ok := interface{}(&pbObj).(Catalog_ItemWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadCatalog_Item(ctx, &Catalog_Item{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(Catalog_ItemWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskCatalog_Item(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(Catalog_ItemWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateCatalog_Item(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(Catalog_ItemWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type Catalog_ItemWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Catalog_Item, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Catalog_Item, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Catalog_Item, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Catalog_Item, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetCatalog_Item executes a bulk gorm update call with patch behavior
+func DefaultPatchSetCatalog_Item(ctx context.Context, objects []*Catalog_Item, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Catalog_Item, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Catalog_Item, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchCatalog_Item(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskCatalog_Item patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCatalog_Item(ctx context.Context, patchee *Catalog_Item, patcher *Catalog_Item, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Catalog_Item, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Sku" {
+			patchee.Sku = patcher.Sku
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListCatalog_Item executes a gorm list call
+func DefaultListCatalog_Item(ctx context.Context, db *gorm.DB) ([]*Catalog_Item, error) {
+	in := Catalog_Item{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &Catalog_ItemORM{}, &Catalog_Item{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []Catalog_ItemORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(Catalog_ItemORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Catalog_Item{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type Catalog_ItemORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type Catalog_ItemORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]Catalog_ItemORM) error
+}
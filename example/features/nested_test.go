@@ -0,0 +1,37 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNested(t *testing.T) {
+	db := openDB(t, &Catalog_ItemORM{}, &CatalogORM{})
+	ctx := context.Background()
+	featured, err := DefaultCreateCatalog_Item(ctx, &Catalog_Item{Sku: "f"}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	catalog, err := DefaultCreateCatalog(ctx, &Catalog{
+		Name:     "a",
+		Items:    []*Catalog_Item{{Sku: "x"}, {Sku: "y"}},
+		Featured: featured,
+	}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadCatalog(ctx, &Catalog{Id: catalog.Id}, db)
+	if err != nil || !proto.Equal(read, catalog) {
+		t.Fatalf("Expected catalog %v, got %v, %v", catalog, read, err)
+	}
+	var count int64
+	if err := db.Table("catalog_items").Where("catalog_id = ?", catalog.Id).Count(&count).Error; err != nil || count != 2 {
+		t.Errorf("Expected 2 items of the catalog, got %d, %v", count, err)
+	}
+	var featuredID uint64
+	if err := db.Table("catalogs").Select("catalog_item_id").Where("id = ?", catalog.Id).Scan(&featuredID).Error; err != nil || featuredID != featured.Id {
+		t.Errorf("Expected featured item %d, got %d, %v", featured.Id, featuredID, err)
+	}
+}
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.11.2
+// source: example/features/oneofs.proto
+
+package features
+
+import (
+	_ "github.com/kirinse/protoc-gen-gorm/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// target is stored in a column per member and a target_case column
+	//
+	// Types that are assignable to Target:
+	//	*Rule_Host
+	//	*Rule_Port
+	//	*Rule_ExpiresAt
+	Target isRule_Target `protobuf_oneof:"target"`
+	// action is stored in a single JSON column
+	//
+	// Types that are assignable to Action:
+	//	*Rule_Redirect
+	//	*Rule_Status
+	Action isRule_Action `protobuf_oneof:"action"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_example_features_oneofs_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_example_features_oneofs_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_example_features_oneofs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rule) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *Rule) GetTarget() isRule_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *Rule) GetHost() string {
+	if x, ok := x.GetTarget().(*Rule_Host); ok {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Rule) GetPort() int64 {
+	if x, ok := x.GetTarget().(*Rule_Port); ok {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Rule) GetExpiresAt() *timestamppb.Timestamp {
+	if x, ok := x.GetTarget().(*Rule_ExpiresAt); ok {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Rule) GetAction() isRule_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *Rule) GetRedirect() string {
+	if x, ok := x.GetAction().(*Rule_Redirect); ok {
+		return x.Redirect
+	}
+	return ""
+}
+
+func (x *Rule) GetStatus() int32 {
+	if x, ok := x.GetAction().(*Rule_Status); ok {
+		return x.Status
+	}
+	return 0
+}
+
+type isRule_Target interface {
+	isRule_Target()
+}
+
+type Rule_Host struct {
+	Host string `protobuf:"bytes,3,opt,name=host,proto3,oneof"`
+}
+
+type Rule_Port struct {
+	Port int64 `protobuf:"varint,4,opt,name=port,proto3,oneof"`
+}
+
+type Rule_ExpiresAt struct {
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,oneof"`
+}
+
+func (*Rule_Host) isRule_Target() {}
+
+func (*Rule_Port) isRule_Target() {}
+
+func (*Rule_ExpiresAt) isRule_Target() {}
+
+type isRule_Action interface {
+	isRule_Action()
+}
+
+type Rule_Redirect struct {
+	Redirect string `protobuf:"bytes,6,opt,name=redirect,proto3,oneof"`
+}
+
+type Rule_Status struct {
+	Status int32 `protobuf:"varint,7,opt,name=status,proto3,oneof"`
+}
+
+func (*Rule_Redirect) isRule_Action() {}
+
+func (*Rule_Status) isRule_Action() {}
+
+var File_example_features_oneofs_proto protoreflect.FileDescriptor
+
+var file_example_features_oneofs_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x2f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x69, 0x72, 0x69, 0x6e, 0x73, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xef, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x42, 0x10, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x08, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6b, 0x69, 0x72, 0x69, 0x6e, 0x73, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3b, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_example_features_oneofs_proto_rawDescOnce sync.Once
+	file_example_features_oneofs_proto_rawDescData = file_example_features_oneofs_proto_rawDesc
+)
+
+func file_example_features_oneofs_proto_rawDescGZIP() []byte {
+	file_example_features_oneofs_proto_rawDescOnce.Do(func() {
+		file_example_features_oneofs_proto_rawDescData = protoimpl.X.CompressGZIP(file_example_features_oneofs_proto_rawDescData)
+	})
+	return file_example_features_oneofs_proto_rawDescData
+}
+
+var file_example_features_oneofs_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_example_features_oneofs_proto_goTypes = []interface{}{
+	(*Rule)(nil),                  // 0: features.Rule
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_example_features_oneofs_proto_depIdxs = []int32{
+	1, // 0: features.Rule.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_example_features_oneofs_proto_init() }
+func file_example_features_oneofs_proto_init() {
+	if File_example_features_oneofs_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_example_features_oneofs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_example_features_oneofs_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Rule_Host)(nil),
+		(*Rule_Port)(nil),
+		(*Rule_ExpiresAt)(nil),
+		(*Rule_Redirect)(nil),
+		(*Rule_Status)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_example_features_oneofs_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_example_features_oneofs_proto_goTypes,
+		DependencyIndexes: file_example_features_oneofs_proto_depIdxs,
+		MessageInfos:      file_example_features_oneofs_proto_msgTypes,
+	}.Build()
+	File_example_features_oneofs_proto = out.File
+	file_example_features_oneofs_proto_rawDesc = nil
+	file_example_features_oneofs_proto_goTypes = nil
+	file_example_features_oneofs_proto_depIdxs = nil
+}
@@ -0,0 +1,529 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+	time "time"
+)
+
+type RuleORM struct {
+	Action     datatypes.JSON
+	ExpiresAt  *time.Time
+	Host       *string
+	Id         uint64
+	Name       string
+	Port       *int64
+	TargetCase string
+}
+
+// TableName overrides the default table name generated by GORM
+func (RuleORM) TableName() string {
+	return "rules"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Rule) ToORM(ctx context.Context) (RuleORM, error) {
+	to := RuleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RuleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	switch v := m.Target.(type) {
+	case *Rule_Host:
+		to.TargetCase = "host"
+		tempHost := v.Host
+		to.Host = &tempHost
+	case *Rule_Port:
+		to.TargetCase = "port"
+		tempPort := v.Port
+		to.Port = &tempPort
+	case *Rule_ExpiresAt:
+		to.TargetCase = "expires_at"
+		if v.ExpiresAt != nil {
+			if !v.ExpiresAt.IsValid() {
+				return to, fmt.Errorf("ExpiresAt invalid")
+			}
+			t := v.ExpiresAt.AsTime()
+			to.ExpiresAt = &t
+		}
+	}
+	if m.Action != nil {
+		if to.Action, err = protojson.Marshal(&Rule{Action: m.Action}); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(RuleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RuleORM) ToPB(ctx context.Context) (Rule, error) {
+	to := Rule{}
+	var err error
+	if prehook, ok := interface{}(m).(RuleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	switch m.TargetCase {
+	case "host":
+		if m.Host != nil {
+			to.Target = &Rule_Host{Host: *m.Host}
+		}
+	case "port":
+		if m.Port != nil {
+			to.Target = &Rule_Port{Port: *m.Port}
+		}
+	case "expires_at":
+		if m.ExpiresAt != nil {
+			to.Target = &Rule_ExpiresAt{ExpiresAt: timestamppb.New(*m.ExpiresAt)}
+		}
+	}
+	if len(m.Action) > 0 {
+		tempAction := &Rule{}
+		if err = protojson.Unmarshal(m.Action, tempAction); err != nil {
+			return to, err
+		}
+		to.Action = tempAction.Action
+	}
+	if posthook, ok := interface{}(m).(RuleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Rule the arg will be the target, the caller the one being converted from
+
+// RuleWithBeforeToORM called before default ToORM code
+type RuleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RuleORM) error
+}
+
+// RuleWithAfterToORM called after default ToORM code
+type RuleWithAfterToORM interface {
+	AfterToORM(context.Context, *RuleORM) error
+}
+
+// RuleWithBeforeToPB called before default ToPB code
+type RuleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Rule) error
+}
+
+// RuleWithAfterToPB called after default ToPB code
+type RuleWithAfterToPB interface {
+	AfterToPB(context.Context, *Rule) error
+}
+
+// DefaultCreateRule executes a basic gorm create call
+func DefaultCreateRule(ctx context.Context, in *Rule, db *gorm.DB) (*Rule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RuleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadRule executes a basic gorm read call
+func DefaultReadRule(ctx context.Context, in *Rule, db *gorm.DB) (*Rule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &RuleORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RuleORM{}
+	if err = db.Where(&RuleORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RuleORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RuleORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRule(ctx context.Context, in *Rule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&RuleORM{Id: ormObj.Id}).Delete(&RuleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RuleORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRuleSet(ctx context.Context, in []*Rule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&RuleORM{})).(RuleORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&RuleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RuleORM{})).(RuleORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RuleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Rule, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Rule, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRule clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRule(ctx context.Context, in *Rule, db *gorm.DB) (*Rule, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateRule")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RuleORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RuleORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRule executes a basic gorm update call with patch behavior
+func DefaultPatchRule(ctx context.Context, in *Rule, updateMask *field_mask.FieldMask, db *gorm.DB) (*Rule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Rule
+	var err error
+	if hook, ok := interface{}(&pbObj).(RuleWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadRule(ctx, &Rule{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(RuleWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRule(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RuleWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRule(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RuleWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RuleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Rule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RuleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Rule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RuleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Rule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RuleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Rule, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRule executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRule(ctx context.Context, objects []*Rule, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Rule, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Rule, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRule(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRule patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRule(ctx context.Context, patchee *Rule, patcher *Rule, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Rule, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Target" {
+			patchee.Target = patcher.Target
+			continue
+		}
+		if f == prefix+"Host" {
+			if _, ok := patcher.Target.(*Rule_Host); ok {
+				patchee.Target = patcher.Target
+			} else if _, ok := patchee.Target.(*Rule_Host); ok {
+				patchee.Target = nil
+			}
+			continue
+		}
+		if f == prefix+"Port" {
+			if _, ok := patcher.Target.(*Rule_Port); ok {
+				patchee.Target = patcher.Target
+			} else if _, ok := patchee.Target.(*Rule_Port); ok {
+				patchee.Target = nil
+			}
+			continue
+		}
+		if f == prefix+"ExpiresAt" {
+			if _, ok := patcher.Target.(*Rule_ExpiresAt); ok {
+				patchee.Target = patcher.Target
+			} else if _, ok := patchee.Target.(*Rule_ExpiresAt); ok {
+				patchee.Target = nil
+			}
+			continue
+		}
+		if f == prefix+"Action" {
+			patchee.Action = patcher.Action
+			continue
+		}
+		if f == prefix+"Redirect" {
+			if _, ok := patcher.Action.(*Rule_Redirect); ok {
+				patchee.Action = patcher.Action
+			} else if _, ok := patchee.Action.(*Rule_Redirect); ok {
+				patchee.Action = nil
+			}
+			continue
+		}
+		if f == prefix+"Status" {
+			if _, ok := patcher.Action.(*Rule_Status); ok {
+				patchee.Action = patcher.Action
+			} else if _, ok := patchee.Action.(*Rule_Status); ok {
+				patchee.Action = nil
+			}
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRule executes a gorm list call
+func DefaultListRule(ctx context.Context, db *gorm.DB) ([]*Rule, error) {
+	in := Rule{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RuleORM{}, &Rule{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []RuleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RuleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Rule{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RuleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RuleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RuleORM) error
+}
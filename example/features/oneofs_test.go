@@ -0,0 +1,77 @@
+package features
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestOneofs(t *testing.T) {
+	db := openDB(t, &RuleORM{})
+	ctx := context.Background()
+	expiresAt := timestamppb.New(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	for _, rule := range []*Rule{
+		{Name: "a", Target: &Rule_Host{Host: "example.com"}, Action: &Rule_Redirect{Redirect: "/a"}},
+		{Name: "b", Target: &Rule_Port{Port: 0}, Action: &Rule_Status{Status: 404}},
+		{Name: "c", Target: &Rule_ExpiresAt{ExpiresAt: expiresAt}},
+		{Name: "d"},
+	} {
+		created, err := DefaultCreateRule(ctx, rule, db)
+		if err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+		read, err := DefaultReadRule(ctx, &Rule{Id: created.Id}, db)
+		if err != nil || !proto.Equal(read, created) {
+			t.Errorf("Expected rule %v, got %v, %v", created, read, err)
+		}
+	}
+	var row struct {
+		TargetCase string
+		Host       *string
+		Port       *int64
+		Action     string
+	}
+	if err := db.Table("rules").Where("id = ?", 2).Scan(&row).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if row.TargetCase != "port" || row.Host != nil || row.Port == nil || *row.Port != 0 || row.Action != `{"status":404}` {
+		t.Errorf("Unexpected columns of rule b: %+v", row)
+	}
+
+	rule, err := DefaultReadRule(ctx, &Rule{Id: 1}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	patcher := &Rule{Target: &Rule_Port{Port: 8080}, Action: &Rule_Status{Status: 301}}
+	mask := &field_mask.FieldMask{Paths: []string{"Port", "Action"}}
+	if rule, err = DefaultApplyFieldMaskRule(ctx, rule, patcher, mask, "", db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if rule.GetPort() != 8080 || rule.GetStatus() != 301 {
+		t.Errorf("Expected port 8080 and status 301, got %v", rule)
+	}
+	mask = &field_mask.FieldMask{Paths: []string{"Host"}}
+	if rule, err = DefaultApplyFieldMaskRule(ctx, rule, &Rule{}, mask, "", db); err != nil || rule.GetPort() != 8080 {
+		t.Errorf("Expected the port kept by a path of another member, got %v, %v", rule, err)
+	}
+	mask = &field_mask.FieldMask{Paths: []string{"Port"}}
+	if rule, err = DefaultApplyFieldMaskRule(ctx, rule, &Rule{}, mask, "", db); err != nil || rule.Target != nil {
+		t.Errorf("Expected the target cleared by an unset member, got %v, %v", rule, err)
+	}
+
+	mask = &field_mask.FieldMask{Paths: []string{"Target"}}
+	patched, err := DefaultPatchRule(ctx, &Rule{Id: 1, Target: &Rule_Port{Port: 443}}, mask, db)
+	if err != nil || patched.GetPort() != 443 || patched.GetRedirect() != "/a" {
+		t.Fatalf("Expected rule a on port 443, got %v, %v", patched, err)
+	}
+	if err := db.Table("rules").Where("id = ?", 1).Scan(&row).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if row.TargetCase != "port" || row.Host != nil || row.Port == nil || *row.Port != 443 {
+		t.Errorf("Unexpected columns of the patched rule a: %+v", row)
+	}
+}
@@ -0,0 +1,531 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+)
+
+type SettingORM struct {
+	Blob     []byte
+	Count    *int64
+	Enabled  *bool
+	Id       uint64
+	MaxItems *int32
+	Note     *string
+	Salt     []byte
+}
+
+// TableName overrides the default table name generated by GORM
+func (SettingORM) TableName() string {
+	return "settings"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Setting) ToORM(ctx context.Context) (SettingORM, error) {
+	to := SettingORM{}
+	var err error
+	if prehook, ok := interface{}(m).(SettingWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.MaxItems != nil {
+		v := *m.MaxItems
+		to.MaxItems = &v
+	}
+	if m.Note != nil {
+		v := *m.Note
+		to.Note = &v
+	}
+	if m.Enabled != nil {
+		v := *m.Enabled
+		to.Enabled = &v
+	}
+	to.Salt = m.Salt
+	if m.Blob != nil {
+		to.Blob = append([]byte{}, m.Blob.Value...)
+	}
+	if m.Count != nil {
+		v := m.Count.Value
+		to.Count = &v
+	}
+	if posthook, ok := interface{}(m).(SettingWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *SettingORM) ToPB(ctx context.Context) (Setting, error) {
+	to := Setting{}
+	var err error
+	if prehook, ok := interface{}(m).(SettingWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.MaxItems != nil {
+		v := *m.MaxItems
+		to.MaxItems = &v
+	}
+	if m.Note != nil {
+		v := *m.Note
+		to.Note = &v
+	}
+	if m.Enabled != nil {
+		v := *m.Enabled
+		to.Enabled = &v
+	}
+	to.Salt = m.Salt
+	if m.Blob != nil {
+		to.Blob = &wrapperspb.BytesValue{Value: m.Blob}
+	}
+	if m.Count != nil {
+		to.Count = &wrapperspb.Int64Value{Value: *m.Count}
+	}
+	if posthook, ok := interface{}(m).(SettingWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Setting the arg will be the target, the caller the one being converted from
+
+// SettingWithBeforeToORM called before default ToORM code
+type SettingWithBeforeToORM interface {
+	BeforeToORM(context.Context, *SettingORM) error
+}
+
+// SettingWithAfterToORM called after default ToORM code
+type SettingWithAfterToORM interface {
+	AfterToORM(context.Context, *SettingORM) error
+}
+
+// SettingWithBeforeToPB called before default ToPB code
+type SettingWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Setting) error
+}
+
+// SettingWithAfterToPB called after default ToPB code
+type SettingWithAfterToPB interface {
+	AfterToPB(context.Context, *Setting) error
+}
+
+// DefaultCreateSetting executes a basic gorm create call
+func DefaultCreateSetting(ctx context.Context, in *Setting, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SettingORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadSetting executes a basic gorm read call
+func DefaultReadSetting(ctx context.Context, in *Setting, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &SettingORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SettingORM{}
+	if err = db.Where(&SettingORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SettingORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SettingORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSetting(ctx context.Context, in *Setting, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&SettingORM{Id: ormObj.Id}).Delete(&SettingORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type SettingORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSettingSet(ctx context.Context, in []*Setting, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&SettingORM{})).(SettingORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&SettingORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&SettingORM{})).(SettingORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type SettingORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Setting, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Setting, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSetting clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSetting(ctx context.Context, in *Setting, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateSetting")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &SettingORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type SettingORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSetting executes a basic gorm update call with patch behavior
+func DefaultPatchSetting(ctx context.Context, in *Setting, updateMask *field_mask.FieldMask, db *gorm.DB) (*Setting, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Setting
+	var err error
+	if hook, ok := interface{}(&pbObj).(SettingWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadSetting(ctx, &Setting{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(SettingWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSetting(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(SettingWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSetting(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(SettingWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type SettingWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SettingWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SettingWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SettingWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Setting, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSetting executes a bulk gorm update call with patch behavior
+func DefaultPatchSetSetting(ctx context.Context, objects []*Setting, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Setting, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Setting, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchSetting(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSetting patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSetting(ctx context.Context, patchee *Setting, patcher *Setting, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Setting, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedBlob bool
+	var updatedCount bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"MaxItems" {
+			patchee.MaxItems = patcher.MaxItems
+			continue
+		}
+		if f == prefix+"Note" {
+			patchee.Note = patcher.Note
+			continue
+		}
+		if f == prefix+"Enabled" {
+			patchee.Enabled = patcher.Enabled
+			continue
+		}
+		if f == prefix+"Salt" {
+			patchee.Salt = patcher.Salt
+			continue
+		}
+		if !updatedBlob && strings.HasPrefix(f, prefix+"Blob.") {
+			if patcher.Blob == nil {
+				patchee.Blob = nil
+				continue
+			}
+			if patchee.Blob == nil {
+				patchee.Blob = &wrapperspb.BytesValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Blob."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Blob, patchee.Blob, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Blob" {
+			updatedBlob = true
+			patchee.Blob = patcher.Blob
+			continue
+		}
+		if !updatedCount && strings.HasPrefix(f, prefix+"Count.") {
+			if patcher.Count == nil {
+				patchee.Count = nil
+				continue
+			}
+			if patchee.Count == nil {
+				patchee.Count = &wrapperspb.Int64Value{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Count."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Count, patchee.Count, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Count" {
+			updatedCount = true
+			patchee.Count = patcher.Count
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSetting executes a gorm list call
+func DefaultListSetting(ctx context.Context, db *gorm.DB) ([]*Setting, error) {
+	in := Setting{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &SettingORM{}, &Setting{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []SettingORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SettingORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Setting{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SettingORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SettingORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SettingORM) error
+}
@@ -0,0 +1,49 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestOptionals(t *testing.T) {
+	db := openDB(t, &SettingORM{})
+	ctx := context.Background()
+	for _, setting := range []*Setting{
+		{},
+		{
+			MaxItems: proto.Int32(0),
+			Note:     proto.String(""),
+			Enabled:  proto.Bool(false),
+			Salt:     []byte{},
+			Blob:     wrapperspb.Bytes(nil),
+			Count:    wrapperspb.Int64(0),
+		},
+		{
+			MaxItems: proto.Int32(5),
+			Note:     proto.String("a"),
+			Enabled:  proto.Bool(true),
+			Salt:     []byte{1},
+			Blob:     wrapperspb.Bytes([]byte{2, 3}),
+			Count:    wrapperspb.Int64(7),
+		},
+	} {
+		created, err := DefaultCreateSetting(ctx, setting, db)
+		if err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+		read, err := DefaultReadSetting(ctx, &Setting{Id: created.Id}, db)
+		if err != nil || !proto.Equal(read, created) {
+			t.Errorf("Expected setting %v, got %v, %v", created, read, err)
+		}
+	}
+	var nulls int64
+	if err := db.Table("settings").Where("max_items IS NULL AND note IS NULL AND enabled IS NULL AND salt IS NULL AND blob IS NULL AND count IS NULL").Count(&nulls).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if nulls != 1 {
+		t.Errorf("Expected the unset fields of a single setting stored as NULL, got %d settings", nulls)
+	}
+}
@@ -0,0 +1,47 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func TestPermissions(t *testing.T) {
+	db := openDB(t, &LoginORM{})
+	ctx := context.Background()
+	login, err := DefaultCreateLogin(ctx, &Login{Name: "n", Login: "l", Score: 3, PasswordHash: "h", Secret: []byte("s")}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if login.PasswordHash != "" || login.Secret != nil {
+		t.Errorf("Expected no write-only fields, got %v", login)
+	}
+	var row LoginORM
+	if err := db.First(&row, login.Id).Error; err != nil || row.PasswordHash != "h" || row.Score != 7 || row.Login != "l" {
+		t.Fatalf("Expected stored login l with score 7, got %v, %v", row, err)
+	}
+	updated, err := DefaultStrictUpdateLogin(ctx, &Login{Id: login.Id, Name: "m", Login: "x", Score: 9}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if updated.Login != "l" || updated.Score != 7 || updated.Name != "m" {
+		t.Errorf("Expected kept login l with score 7, got %v", updated)
+	}
+	row = LoginORM{}
+	if err := db.First(&row, login.Id).Error; err != nil || row.PasswordHash != "h" || string(row.Secret) != "s" {
+		t.Fatalf("Expected kept write-only fields, got %v, %v", row, err)
+	}
+	for _, path := range []string{"Login", "Score", "VerifiedAt"} {
+		if _, err := DefaultPatchLogin(ctx, &Login{Id: login.Id}, &field_mask.FieldMask{Paths: []string{path}}, db); err == nil {
+			t.Errorf("Expected error patching %s but didn't get any", path)
+		}
+	}
+	if _, err := DefaultPatchLogin(ctx, &Login{Id: login.Id, PasswordHash: "g"}, &field_mask.FieldMask{Paths: []string{"PasswordHash"}}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	row = LoginORM{}
+	if err := db.First(&row, login.Id).Error; err != nil || row.PasswordHash != "g" || row.Name != "m" {
+		t.Fatalf("Expected patched password hash, got %v, %v", row, err)
+	}
+}
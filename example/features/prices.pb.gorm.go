@@ -0,0 +1,475 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	types "github.com/kirinse/protoc-gen-gorm/types"
+	money "google.golang.org/genproto/googleapis/type/money"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	proto "google.golang.org/protobuf/proto"
+	gorm "gorm.io/gorm"
+	strings "strings"
+)
+
+type PriceORM struct {
+	AmountCurrencyCode string `gorm:"column:amount_currency_code"`
+	AmountNanos        int32  `gorm:"column:amount_nanos"`
+	AmountUnits        int64  `gorm:"column:amount_units"`
+	Id                 uint64
+	Rate               *types.Numeric `gorm:"type:text;precision:10;scale:4"`
+}
+
+// TableName overrides the default table name generated by GORM
+func (PriceORM) TableName() string {
+	return "prices"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Price) ToORM(ctx context.Context) (PriceORM, error) {
+	to := PriceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PriceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Amount != nil {
+		to.AmountCurrencyCode = m.Amount.CurrencyCode
+		to.AmountUnits = m.Amount.Units
+		to.AmountNanos = m.Amount.Nanos
+	}
+	if m.Rate != nil {
+		if to.Rate, err = types.ParseNumeric(m.Rate.Value); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(PriceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PriceORM) ToPB(ctx context.Context) (Price, error) {
+	to := Price{}
+	var err error
+	if prehook, ok := interface{}(m).(PriceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Amount = &money.Money{}
+	to.Amount.CurrencyCode = m.AmountCurrencyCode
+	to.Amount.Units = m.AmountUnits
+	to.Amount.Nanos = m.AmountNanos
+	if proto.Size(to.Amount) == 0 {
+		to.Amount = nil
+	}
+	if m.Rate != nil {
+		to.Rate = &types.Decimal{Value: m.Rate.String()}
+	}
+	if posthook, ok := interface{}(m).(PriceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Price the arg will be the target, the caller the one being converted from
+
+// PriceWithBeforeToORM called before default ToORM code
+type PriceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PriceORM) error
+}
+
+// PriceWithAfterToORM called after default ToORM code
+type PriceWithAfterToORM interface {
+	AfterToORM(context.Context, *PriceORM) error
+}
+
+// PriceWithBeforeToPB called before default ToPB code
+type PriceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Price) error
+}
+
+// PriceWithAfterToPB called after default ToPB code
+type PriceWithAfterToPB interface {
+	AfterToPB(context.Context, *Price) error
+}
+
+// DefaultCreatePrice executes a basic gorm create call
+func DefaultCreatePrice(ctx context.Context, in *Price, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PriceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadPrice executes a basic gorm read call
+func DefaultReadPrice(ctx context.Context, in *Price, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PriceORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PriceORM{}
+	if err = db.Where(&PriceORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PriceORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PriceORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrice(ctx context.Context, in *Price, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&PriceORM{Id: ormObj.Id}).Delete(&PriceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PriceORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePriceSet(ctx context.Context, in []*Price, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PriceORM{})).(PriceORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PriceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PriceORM{})).(PriceORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PriceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Price, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Price, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrice clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrice(ctx context.Context, in *Price, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdatePrice")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &PriceORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type PriceORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrice executes a basic gorm update call with patch behavior
+func DefaultPatchPrice(ctx context.Context, in *Price, updateMask *field_mask.FieldMask, db *gorm.DB) (*Price, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Price
+	var err error
+	if hook, ok := interface{}(&pbObj).(PriceWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPrice(ctx, &Price{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PriceWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrice(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PriceWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrice(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PriceWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PriceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PriceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Price, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrice executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrice(ctx context.Context, objects []*Price, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Price, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Price, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPrice(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrice patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrice(ctx context.Context, patchee *Price, patcher *Price, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Price, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Amount" {
+			patchee.Amount = patcher.Amount
+			continue
+		}
+		if strings.HasPrefix(f, prefix+"Amount.") {
+			if patchee.Amount == nil {
+				patchee.Amount = &money.Money{}
+			}
+			source := patcher.Amount
+			if source == nil {
+				source = &money.Money{}
+			}
+			if f == prefix+"Amount.CurrencyCode" {
+				patchee.Amount.CurrencyCode = source.CurrencyCode
+			}
+			if f == prefix+"Amount.Units" {
+				patchee.Amount.Units = source.Units
+			}
+			if f == prefix+"Amount.Nanos" {
+				patchee.Amount.Nanos = source.Nanos
+			}
+			continue
+		}
+		if f == prefix+"Rate" {
+			patchee.Rate = patcher.Rate
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPrice executes a gorm list call
+func DefaultListPrice(ctx context.Context, db *gorm.DB) ([]*Price, error) {
+	in := Price{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PriceORM{}, &Price{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []PriceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PriceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Price{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PriceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PriceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PriceORM) error
+}
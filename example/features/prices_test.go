@@ -0,0 +1,32 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirinse/protoc-gen-gorm/types"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPrices(t *testing.T) {
+	db := openDB(t, &PriceORM{})
+	ctx := context.Background()
+	price := &Price{
+		Amount: &money.Money{CurrencyCode: "EUR", Units: 12, Nanos: 340000000},
+		Rate:   &types.Decimal{Value: "123456.1250"},
+	}
+	created, err := DefaultCreatePrice(ctx, price, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	// the decimal is stored as text on SQLite, which would keep it as REAL
+	read, err := DefaultReadPrice(ctx, &Price{Id: created.Id}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	price.Id = created.Id
+	if !proto.Equal(read, price) {
+		t.Errorf("Expected %v, got %v", price, read)
+	}
+}
@@ -0,0 +1,474 @@
+package features
+
+import (
+	context "context"
+	json "encoding/json"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+)
+
+type ScheduleORM struct {
+	DayNames datatypes.JSON
+	Days     datatypes.JSON
+	Id       uint64
+}
+
+// TableName overrides the default table name generated by GORM
+func (ScheduleORM) TableName() string {
+	return "schedules"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Schedule) ToORM(ctx context.Context) (ScheduleORM, error) {
+	to := ScheduleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ScheduleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Days != nil {
+		tempDays := make([]int32, len(m.Days))
+		for i, v := range m.Days {
+			tempDays[i] = int32(v)
+		}
+		if to.Days, err = json.Marshal(tempDays); err != nil {
+			return to, err
+		}
+	}
+	if m.DayNames != nil {
+		tempDayNames := make([]string, len(m.DayNames))
+		for i, v := range m.DayNames {
+			tempDayNames[i] = Weekday_name[int32(v)]
+		}
+		if to.DayNames, err = json.Marshal(tempDayNames); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(ScheduleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ScheduleORM) ToPB(ctx context.Context) (Schedule, error) {
+	to := Schedule{}
+	var err error
+	if prehook, ok := interface{}(m).(ScheduleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if len(m.Days) > 0 {
+		var tempDays []int32
+		if err = json.Unmarshal(m.Days, &tempDays); err != nil {
+			return to, err
+		}
+		to.Days = make([]Weekday, len(tempDays))
+		for i, v := range tempDays {
+			to.Days[i] = Weekday(v)
+		}
+	}
+	if len(m.DayNames) > 0 {
+		var tempDayNames []string
+		if err = json.Unmarshal(m.DayNames, &tempDayNames); err != nil {
+			return to, err
+		}
+		to.DayNames = make([]Weekday, len(tempDayNames))
+		for i, v := range tempDayNames {
+			value, ok := Weekday_value[v]
+			if !ok && v != "" {
+				return to, fmt.Errorf("unknown Weekday value %q", v)
+			}
+			to.DayNames[i] = Weekday(value)
+		}
+	}
+	if posthook, ok := interface{}(m).(ScheduleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Schedule the arg will be the target, the caller the one being converted from
+
+// ScheduleWithBeforeToORM called before default ToORM code
+type ScheduleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ScheduleORM) error
+}
+
+// ScheduleWithAfterToORM called after default ToORM code
+type ScheduleWithAfterToORM interface {
+	AfterToORM(context.Context, *ScheduleORM) error
+}
+
+// ScheduleWithBeforeToPB called before default ToPB code
+type ScheduleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Schedule) error
+}
+
+// ScheduleWithAfterToPB called after default ToPB code
+type ScheduleWithAfterToPB interface {
+	AfterToPB(context.Context, *Schedule) error
+}
+
+// DefaultCreateSchedule executes a basic gorm create call
+func DefaultCreateSchedule(ctx context.Context, in *Schedule, db *gorm.DB) (*Schedule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ScheduleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadSchedule executes a basic gorm read call
+func DefaultReadSchedule(ctx context.Context, in *Schedule, db *gorm.DB) (*Schedule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ScheduleORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ScheduleORM{}
+	if err = db.Where(&ScheduleORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ScheduleORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ScheduleORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSchedule(ctx context.Context, in *Schedule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ScheduleORM{Id: ormObj.Id}).Delete(&ScheduleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ScheduleORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteScheduleSet(ctx context.Context, in []*Schedule, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ScheduleORM{})).(ScheduleORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ScheduleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ScheduleORM{})).(ScheduleORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ScheduleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Schedule, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Schedule, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSchedule clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSchedule(ctx context.Context, in *Schedule, db *gorm.DB) (*Schedule, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateSchedule")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ScheduleORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ScheduleORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSchedule executes a basic gorm update call with patch behavior
+func DefaultPatchSchedule(ctx context.Context, in *Schedule, updateMask *field_mask.FieldMask, db *gorm.DB) (*Schedule, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Schedule
+	var err error
+	if hook, ok := interface{}(&pbObj).(ScheduleWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadSchedule(ctx, &Schedule{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ScheduleWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSchedule(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ScheduleWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSchedule(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ScheduleWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ScheduleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Schedule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Schedule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Schedule, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Schedule, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSchedule executes a bulk gorm update call with patch behavior
+func DefaultPatchSetSchedule(ctx context.Context, objects []*Schedule, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Schedule, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Schedule, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchSchedule(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSchedule patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSchedule(ctx context.Context, patchee *Schedule, patcher *Schedule, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Schedule, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Days" {
+			patchee.Days = patcher.Days
+			continue
+		}
+		if f == prefix+"DayNames" {
+			patchee.DayNames = patcher.DayNames
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSchedule executes a gorm list call
+func DefaultListSchedule(ctx context.Context, db *gorm.DB) ([]*Schedule, error) {
+	in := Schedule{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ScheduleORM{}, &Schedule{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []ScheduleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ScheduleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Schedule{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ScheduleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ScheduleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ScheduleORM) error
+}
@@ -0,0 +1,40 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRepeatedEnums(t *testing.T) {
+	db := openDB(t, &ScheduleORM{})
+	ctx := context.Background()
+	schedule, err := DefaultCreateSchedule(ctx, &Schedule{
+		Days:     []Weekday{Weekday_WEEKDAY_MONDAY, Weekday_WEEKDAY_WEDNESDAY},
+		DayNames: []Weekday{Weekday_WEEKDAY_TUESDAY, Weekday_WEEKDAY_UNSPECIFIED},
+	}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadSchedule(ctx, &Schedule{Id: schedule.Id}, db)
+	if err != nil || !proto.Equal(read, schedule) {
+		t.Fatalf("Expected schedule %v, got %v, %v", schedule, read, err)
+	}
+	var row struct {
+		Days     string
+		DayNames string
+	}
+	if err := db.Table("schedules").Where("id = ?", schedule.Id).Scan(&row).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if row.Days != `[1,3]` || row.DayNames != `["WEEKDAY_TUESDAY","WEEKDAY_UNSPECIFIED"]` {
+		t.Errorf("Unexpected columns of the schedule: %+v", row)
+	}
+	if err := db.Table("schedules").Where("id = ?", schedule.Id).Update("day_names", `["WEEKDAY_FRIDAY"]`).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if _, err := DefaultReadSchedule(ctx, &Schedule{Id: schedule.Id}, db); err == nil {
+		t.Errorf("Expected an error reading an unknown day name")
+	}
+}
@@ -0,0 +1,1178 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	types "github.com/kirinse/protoc-gen-gorm/types"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+)
+
+type MemoORM struct {
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	Id        uint64
+	Text      string
+}
+
+// TableName overrides the default table name generated by GORM
+func (MemoORM) TableName() string {
+	return "memos"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Memo) ToORM(ctx context.Context) (MemoORM, error) {
+	to := MemoORM{}
+	var err error
+	if prehook, ok := interface{}(m).(MemoWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if m.DeletedAt != nil {
+		if !m.DeletedAt.IsValid() {
+			return to, fmt.Errorf("DeletedAt invalid")
+		}
+		t := m.DeletedAt.AsTime()
+		to.DeletedAt = gorm.DeletedAt{Time: t, Valid: true}
+	}
+	if posthook, ok := interface{}(m).(MemoWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *MemoORM) ToPB(ctx context.Context) (Memo, error) {
+	to := Memo{}
+	var err error
+	if prehook, ok := interface{}(m).(MemoWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if m.DeletedAt.Valid {
+		to.DeletedAt = timestamppb.New(m.DeletedAt.Time)
+	}
+	if posthook, ok := interface{}(m).(MemoWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Memo the arg will be the target, the caller the one being converted from
+
+// MemoWithBeforeToORM called before default ToORM code
+type MemoWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MemoORM) error
+}
+
+// MemoWithAfterToORM called after default ToORM code
+type MemoWithAfterToORM interface {
+	AfterToORM(context.Context, *MemoORM) error
+}
+
+// MemoWithBeforeToPB called before default ToPB code
+type MemoWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Memo) error
+}
+
+// MemoWithAfterToPB called after default ToPB code
+type MemoWithAfterToPB interface {
+	AfterToPB(context.Context, *Memo) error
+}
+
+type NoteORM struct {
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	NoteId    uint64         `gorm:"primaryKey"`
+	Text      string
+}
+
+// TableName overrides the default table name generated by GORM
+func (NoteORM) TableName() string {
+	return "notes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Note) ToORM(ctx context.Context) (NoteORM, error) {
+	to := NoteORM{}
+	var err error
+	if prehook, ok := interface{}(m).(NoteWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.NoteId = m.NoteId
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(NoteWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *NoteORM) ToPB(ctx context.Context) (Note, error) {
+	to := Note{}
+	var err error
+	if prehook, ok := interface{}(m).(NoteWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.NoteId = m.NoteId
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(NoteWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Note the arg will be the target, the caller the one being converted from
+
+// NoteWithBeforeToORM called before default ToORM code
+type NoteWithBeforeToORM interface {
+	BeforeToORM(context.Context, *NoteORM) error
+}
+
+// NoteWithAfterToORM called after default ToORM code
+type NoteWithAfterToORM interface {
+	AfterToORM(context.Context, *NoteORM) error
+}
+
+// NoteWithBeforeToPB called before default ToPB code
+type NoteWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Note) error
+}
+
+// NoteWithAfterToPB called after default ToPB code
+type NoteWithAfterToPB interface {
+	AfterToPB(context.Context, *Note) error
+}
+
+// DefaultCreateMemo executes a basic gorm create call
+func DefaultCreateMemo(ctx context.Context, in *Memo, db *gorm.DB) (*Memo, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MemoORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadMemo executes a basic gorm read call
+func DefaultReadMemo(ctx context.Context, in *Memo, db *gorm.DB) (*Memo, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &MemoORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MemoORM{}
+	if err = db.Where(&MemoORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MemoORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MemoORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMemo(ctx context.Context, in *Memo, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&MemoORM{Id: ormObj.Id}).Delete(&MemoORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MemoORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMemoSet(ctx context.Context, in []*Memo, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&MemoORM{})).(MemoORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&MemoORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MemoORM{})).(MemoORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MemoORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Memo, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Memo, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMemo clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMemo(ctx context.Context, in *Memo, db *gorm.DB) (*Memo, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateMemo")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &MemoORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type MemoORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMemo executes a basic gorm update call with patch behavior
+func DefaultPatchMemo(ctx context.Context, in *Memo, updateMask *field_mask.FieldMask, db *gorm.DB) (*Memo, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Memo
+	var err error
+	if hook, ok := interface{}(&pbObj).(MemoWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadMemo(ctx, &Memo{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(MemoWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMemo(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(MemoWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMemo(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MemoWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MemoWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Memo, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MemoWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Memo, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MemoWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Memo, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MemoWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Memo, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMemo executes a bulk gorm update call with patch behavior
+func DefaultPatchSetMemo(ctx context.Context, objects []*Memo, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Memo, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Memo, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchMemo(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultRestoreMemo executes a gorm update call clearing the deleted at time of a soft deleted Memo
+func DefaultRestoreMemo(ctx context.Context, in *Memo, db *gorm.DB) (*Memo, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeRestore); ok {
+		if db, err = hook.BeforeRestore(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Unscoped().Model(&MemoORM{}).Where(&MemoORM{Id: ormObj.Id}).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+	ormResponse := MemoORM{}
+	if err = db.Where(&MemoORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithAfterRestore); ok {
+		if err = hook.AfterRestore(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MemoORMWithBeforeRestore interface {
+	BeforeRestore(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterRestore interface {
+	AfterRestore(context.Context, *gorm.DB) error
+}
+
+// DefaultHardDeleteMemo executes a gorm delete call removing the Memo for good, deleted or not
+func DefaultHardDeleteMemo(ctx context.Context, in *Memo, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeHardDelete); ok {
+		if db, err = hook.BeforeHardDelete(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Unscoped().Where(&MemoORM{Id: ormObj.Id}).Delete(&MemoORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithAfterHardDelete); ok {
+		err = hook.AfterHardDelete(ctx, db)
+	}
+	return err
+}
+
+type MemoORMWithBeforeHardDelete interface {
+	BeforeHardDelete(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterHardDelete interface {
+	AfterHardDelete(context.Context, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskMemo patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMemo(ctx context.Context, patchee *Memo, patcher *Memo, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Memo, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedDeletedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
+			continue
+		}
+		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
+			if patcher.DeletedAt == nil {
+				patchee.DeletedAt = nil
+				continue
+			}
+			if patchee.DeletedAt == nil {
+				patchee.DeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"DeletedAt" {
+			updatedDeletedAt = true
+			patchee.DeletedAt = patcher.DeletedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListMemo executes a gorm list call
+func DefaultListMemo(ctx context.Context, db *gorm.DB) ([]*Memo, error) {
+	in := Memo{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MemoORM{}, &Memo{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	switch types.DeletedFilterFromContext(ctx) {
+	case types.IncludeDeleted:
+		db = db.Unscoped()
+	case types.OnlyDeleted:
+		db = db.Unscoped().Where("memos.deleted_at IS NOT NULL")
+	}
+	db = db.Order("id")
+	ormResponse := []MemoORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MemoORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Memo{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MemoORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MemoORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MemoORM) error
+}
+
+// DefaultCreateNote executes a basic gorm create call
+func DefaultCreateNote(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NoteORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadNote executes a basic gorm read call
+func DefaultReadNote(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.NoteId == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &NoteORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := NoteORM{}
+	if err = db.Where(&NoteORM{NoteId: ormObj.NoteId}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(NoteORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NoteORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNote(ctx context.Context, in *Note, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.NoteId == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&NoteORM{NoteId: ormObj.NoteId}).Delete(&NoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type NoteORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNoteSet(ctx context.Context, in []*Note, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.NoteId == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.NoteId)
+	}
+	if hook, ok := (interface{}(&NoteORM{})).(NoteORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("note_id in (?)", keys).Delete(&NoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&NoteORM{})).(NoteORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type NoteORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Note, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Note, *gorm.DB) error
+}
+
+// DefaultStrictUpdateNote clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateNote(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateNote")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &NoteORM{}
+	db.Model(&ormObj).Where("note_id=?", ormObj.NoteId).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type NoteORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchNote executes a basic gorm update call with patch behavior
+func DefaultPatchNote(ctx context.Context, in *Note, updateMask *field_mask.FieldMask, db *gorm.DB) (*Note, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Note
+	var err error
+	if hook, ok := interface{}(&pbObj).(NoteWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadNote(ctx, &Note{NoteId: in.NoteId}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(NoteWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskNote(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(NoteWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateNote(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(NoteWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type NoteWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NoteWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NoteWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NoteWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetNote executes a bulk gorm update call with patch behavior
+func DefaultPatchSetNote(ctx context.Context, objects []*Note, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Note, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Note, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchNote(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultRestoreNote executes a gorm update call clearing the deleted at time of a soft deleted Note
+func DefaultRestoreNote(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.NoteId == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeRestore); ok {
+		if db, err = hook.BeforeRestore(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Unscoped().Model(&NoteORM{}).Where(&NoteORM{NoteId: ormObj.NoteId}).Update("deleted_at", nil).Error; err != nil {
+		return nil, err
+	}
+	ormResponse := NoteORM{}
+	if err = db.Where(&NoteORM{NoteId: ormObj.NoteId}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterRestore); ok {
+		if err = hook.AfterRestore(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NoteORMWithBeforeRestore interface {
+	BeforeRestore(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterRestore interface {
+	AfterRestore(context.Context, *gorm.DB) error
+}
+
+// DefaultHardDeleteNote executes a gorm delete call removing the Note for good, deleted or not
+func DefaultHardDeleteNote(ctx context.Context, in *Note, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.NoteId == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeHardDelete); ok {
+		if db, err = hook.BeforeHardDelete(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Unscoped().Where(&NoteORM{NoteId: ormObj.NoteId}).Delete(&NoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterHardDelete); ok {
+		err = hook.AfterHardDelete(ctx, db)
+	}
+	return err
+}
+
+type NoteORMWithBeforeHardDelete interface {
+	BeforeHardDelete(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterHardDelete interface {
+	AfterHardDelete(context.Context, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskNote patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskNote(ctx context.Context, patchee *Note, patcher *Note, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Note, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"NoteId" {
+			patchee.NoteId = patcher.NoteId
+			continue
+		}
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListNote executes a gorm list call
+func DefaultListNote(ctx context.Context, db *gorm.DB) ([]*Note, error) {
+	in := Note{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &NoteORM{}, &Note{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	switch types.DeletedFilterFromContext(ctx) {
+	case types.IncludeDeleted:
+		db = db.Unscoped()
+	case types.OnlyDeleted:
+		db = db.Unscoped().Where("notes.deleted_at IS NOT NULL")
+	}
+	db = db.Order("note_id")
+	ormResponse := []NoteORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Note{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type NoteORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]NoteORM) error
+}
+type MemosDefaultServer struct {
+	DB *gorm.DB
+}
+
+// UndeleteMemo ...
+func (m *MemosDefaultServer) UndeleteMemo(ctx context.Context, in *UndeleteMemoRequest) (*UndeleteMemoResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(MemosMemoWithBeforeUndeleteMemo); ok {
+		var err error
+		if db, err = custom.BeforeUndeleteMemo(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultRestoreMemo(ctx, &Memo{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &UndeleteMemoResponse{Result: res}
+	if custom, ok := interface{}(in).(MemosMemoWithAfterUndeleteMemo); ok {
+		var err error
+		if err = custom.AfterUndeleteMemo(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// MemosMemoWithBeforeUndeleteMemo called before DefaultRestoreMemo in the default UndeleteMemo handler
+type MemosMemoWithBeforeUndeleteMemo interface {
+	BeforeUndeleteMemo(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// MemosMemoWithAfterUndeleteMemo called after DefaultRestoreMemo in the default UndeleteMemo handler
+type MemosMemoWithAfterUndeleteMemo interface {
+	AfterUndeleteMemo(context.Context, *UndeleteMemoResponse, *gorm.DB) error
+}
+
+// PurgeMemo ...
+func (m *MemosDefaultServer) PurgeMemo(ctx context.Context, in *PurgeMemoRequest) (*PurgeMemoResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(MemosMemoWithBeforePurgeMemo); ok {
+		var err error
+		if db, err = custom.BeforePurgeMemo(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultHardDeleteMemo(ctx, &Memo{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &PurgeMemoResponse{}
+	if custom, ok := interface{}(in).(MemosMemoWithAfterPurgeMemo); ok {
+		var err error
+		if err = custom.AfterPurgeMemo(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// MemosMemoWithBeforePurgeMemo called before DefaultHardDeleteMemo in the default PurgeMemo handler
+type MemosMemoWithBeforePurgeMemo interface {
+	BeforePurgeMemo(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// MemosMemoWithAfterPurgeMemo called after DefaultHardDeleteMemo in the default PurgeMemo handler
+type MemosMemoWithAfterPurgeMemo interface {
+	AfterPurgeMemo(context.Context, *PurgeMemoResponse, *gorm.DB) error
+}
+
+// UndeleteNote ...
+func (m *MemosDefaultServer) UndeleteNote(ctx context.Context, in *UndeleteNoteRequest) (*UndeleteNoteResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(MemosNoteWithBeforeUndeleteNote); ok {
+		var err error
+		if db, err = custom.BeforeUndeleteNote(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultRestoreNote(ctx, &Note{NoteId: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &UndeleteNoteResponse{Result: res}
+	if custom, ok := interface{}(in).(MemosNoteWithAfterUndeleteNote); ok {
+		var err error
+		if err = custom.AfterUndeleteNote(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// MemosNoteWithBeforeUndeleteNote called before DefaultRestoreNote in the default UndeleteNote handler
+type MemosNoteWithBeforeUndeleteNote interface {
+	BeforeUndeleteNote(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// MemosNoteWithAfterUndeleteNote called after DefaultRestoreNote in the default UndeleteNote handler
+type MemosNoteWithAfterUndeleteNote interface {
+	AfterUndeleteNote(context.Context, *UndeleteNoteResponse, *gorm.DB) error
+}
+
+// PurgeNote ...
+func (m *MemosDefaultServer) PurgeNote(ctx context.Context, in *PurgeNoteRequest) (*PurgeNoteResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(MemosNoteWithBeforePurgeNote); ok {
+		var err error
+		if db, err = custom.BeforePurgeNote(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultHardDeleteNote(ctx, &Note{NoteId: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &PurgeNoteResponse{}
+	if custom, ok := interface{}(in).(MemosNoteWithAfterPurgeNote); ok {
+		var err error
+		if err = custom.AfterPurgeNote(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// MemosNoteWithBeforePurgeNote called before DefaultHardDeleteNote in the default PurgeNote handler
+type MemosNoteWithBeforePurgeNote interface {
+	BeforePurgeNote(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// MemosNoteWithAfterPurgeNote called after DefaultHardDeleteNote in the default PurgeNote handler
+type MemosNoteWithAfterPurgeNote interface {
+	AfterPurgeNote(context.Context, *PurgeNoteResponse, *gorm.DB) error
+}
@@ -0,0 +1,87 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirinse/protoc-gen-gorm/types"
+	"gorm.io/gorm"
+)
+
+func TestSoftDelete(t *testing.T) {
+	db := openDB(t, &MemoORM{})
+	ctx := context.Background()
+	for _, text := range []string{"a", "b", "c"} {
+		if _, err := DefaultCreateMemo(ctx, &Memo{Text: text}, db); err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+	}
+	if err := DefaultDeleteMemo(ctx, &Memo{Id: 1}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if err := DefaultDeleteMemoSet(ctx, []*Memo{{Id: 2}}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if _, err := DefaultReadMemo(ctx, &Memo{Id: 1}, db); err != gorm.ErrRecordNotFound {
+		t.Errorf("Expected %s reading a deleted memo, got %v", gorm.ErrRecordNotFound, err)
+	}
+	for filter, expected := range map[types.DeletedFilter]int{
+		types.ExcludeDeleted: 1,
+		types.IncludeDeleted: 3,
+		types.OnlyDeleted:    2,
+	} {
+		list, err := DefaultListMemo(types.WithDeletedFilter(ctx, filter), db)
+		if err != nil || len(list) != expected {
+			t.Errorf("Expected %d memos with filter %v, got %v, %v", expected, filter, list, err)
+		}
+	}
+	memo, err := DefaultRestoreMemo(ctx, &Memo{Id: 1}, db)
+	if err != nil || memo.Text != "a" || memo.DeletedAt != nil {
+		t.Fatalf("Expected restored memo a, got %v, %v", memo, err)
+	}
+	if _, err := DefaultRestoreMemo(ctx, &Memo{Id: 9}, db); err != gorm.ErrRecordNotFound {
+		t.Errorf("Expected %s restoring a missing memo, got %v", gorm.ErrRecordNotFound, err)
+	}
+	server := &MemosDefaultServer{DB: db}
+	if _, err := server.PurgeMemo(ctx, &PurgeMemoRequest{Id: 2}); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if err := DefaultDeleteMemo(ctx, &Memo{Id: 1}, db); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	res, err := server.UndeleteMemo(ctx, &UndeleteMemoRequest{Id: 1})
+	if err != nil || res.Result.Text != "a" {
+		t.Fatalf("Expected undeleted memo a, got %v, %v", res, err)
+	}
+	list, err := DefaultListMemo(types.WithDeletedFilter(ctx, types.IncludeDeleted), db)
+	if err != nil || len(list) != 2 {
+		t.Errorf("Expected 2 memos after the purge, got %v, %v", list, err)
+	}
+}
+
+func TestSoftDeleteByPrimaryKey(t *testing.T) {
+	db := openDB(t, &NoteORM{})
+	ctx := context.Background()
+	for _, text := range []string{"a", "b"} {
+		if _, err := DefaultCreateNote(ctx, &Note{Text: text}, db); err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+	}
+	for _, id := range []uint64{1, 2} {
+		if err := DefaultDeleteNote(ctx, &Note{NoteId: id}, db); err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+	}
+	server := &MemosDefaultServer{DB: db}
+	res, err := server.UndeleteNote(ctx, &UndeleteNoteRequest{Id: 2})
+	if err != nil || res.Result.NoteId != 2 || res.Result.Text != "b" {
+		t.Fatalf("Expected undeleted note b, got %v, %v", res, err)
+	}
+	if _, err := server.PurgeNote(ctx, &PurgeNoteRequest{Id: 1}); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	list, err := DefaultListNote(types.WithDeletedFilter(ctx, types.IncludeDeleted), db)
+	if err != nil || len(list) != 1 || list[0].NoteId != 2 {
+		t.Errorf("Expected note b alone after the purge, got %v, %v", list, err)
+	}
+}
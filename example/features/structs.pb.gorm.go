@@ -0,0 +1,478 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	datatypes "gorm.io/datatypes"
+	gorm "gorm.io/gorm"
+	strings "strings"
+)
+
+type DocumentORM struct {
+	Body     datatypes.JSON
+	Id       uint64
+	Metadata datatypes.JSON
+	Tags     datatypes.JSON
+}
+
+// TableName overrides the default table name generated by GORM
+func (DocumentORM) TableName() string {
+	return "documents"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Document) ToORM(ctx context.Context) (DocumentORM, error) {
+	to := DocumentORM{}
+	var err error
+	if prehook, ok := interface{}(m).(DocumentWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Metadata != nil {
+		if to.Metadata, err = protojson.Marshal(m.Metadata); err != nil {
+			return to, err
+		}
+	}
+	if m.Body != nil {
+		if to.Body, err = protojson.Marshal(m.Body); err != nil {
+			return to, err
+		}
+	}
+	if m.Tags != nil {
+		if to.Tags, err = protojson.Marshal(m.Tags); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(DocumentWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *DocumentORM) ToPB(ctx context.Context) (Document, error) {
+	to := Document{}
+	var err error
+	if prehook, ok := interface{}(m).(DocumentWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if len(m.Metadata) > 0 {
+		to.Metadata = &structpb.Struct{}
+		if err = protojson.Unmarshal(m.Metadata, to.Metadata); err != nil {
+			return to, err
+		}
+	}
+	if len(m.Body) > 0 {
+		to.Body = &structpb.Value{}
+		if err = protojson.Unmarshal(m.Body, to.Body); err != nil {
+			return to, err
+		}
+	}
+	if len(m.Tags) > 0 {
+		to.Tags = &structpb.ListValue{}
+		if err = protojson.Unmarshal(m.Tags, to.Tags); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(DocumentWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Document the arg will be the target, the caller the one being converted from
+
+// DocumentWithBeforeToORM called before default ToORM code
+type DocumentWithBeforeToORM interface {
+	BeforeToORM(context.Context, *DocumentORM) error
+}
+
+// DocumentWithAfterToORM called after default ToORM code
+type DocumentWithAfterToORM interface {
+	AfterToORM(context.Context, *DocumentORM) error
+}
+
+// DocumentWithBeforeToPB called before default ToPB code
+type DocumentWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Document) error
+}
+
+// DocumentWithAfterToPB called after default ToPB code
+type DocumentWithAfterToPB interface {
+	AfterToPB(context.Context, *Document) error
+}
+
+// DefaultCreateDocument executes a basic gorm create call
+func DefaultCreateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadDocument executes a basic gorm read call
+func DefaultReadDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &DocumentORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := DocumentORM{}
+	if err = db.Where(&DocumentORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(DocumentORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDocument(ctx context.Context, in *Document, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&DocumentORM{Id: ormObj.Id}).Delete(&DocumentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type DocumentORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDocumentSet(ctx context.Context, in []*Document, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&DocumentORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type DocumentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Document, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Document, *gorm.DB) error
+}
+
+// DefaultStrictUpdateDocument clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateDocument")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &DocumentORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type DocumentORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchDocument executes a basic gorm update call with patch behavior
+func DefaultPatchDocument(ctx context.Context, in *Document, updateMask *field_mask.FieldMask, db *gorm.DB) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Document
+	var err error
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadDocument(ctx, &Document{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskDocument(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateDocument(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(DocumentWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type DocumentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetDocument executes a bulk gorm update call with patch behavior
+func DefaultPatchSetDocument(ctx context.Context, objects []*Document, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Document, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Document, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchDocument(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskDocument patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskDocument(ctx context.Context, patchee *Document, patcher *Document, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Document, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedMetadata bool
+	var updatedBody bool
+	var updatedTags bool
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedMetadata && strings.HasPrefix(f, prefix+"Metadata") {
+			patchee.Metadata = patcher.Metadata
+			updatedMetadata = true
+			continue
+		}
+		if !updatedBody && strings.HasPrefix(f, prefix+"Body") {
+			patchee.Body = patcher.Body
+			updatedBody = true
+			continue
+		}
+		if !updatedTags && strings.HasPrefix(f, prefix+"Tags") {
+			patchee.Tags = patcher.Tags
+			updatedTags = true
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListDocument executes a gorm list call
+func DefaultListDocument(ctx context.Context, db *gorm.DB) ([]*Document, error) {
+	in := Document{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &DocumentORM{}, &Document{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []DocumentORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Document{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type DocumentORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DocumentORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]DocumentORM) error
+}
@@ -0,0 +1,45 @@
+package features
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStructs(t *testing.T) {
+	db := openDB(t, &DocumentORM{})
+	ctx := context.Background()
+	metadata, _ := structpb.NewStruct(map[string]interface{}{"owner": "ann", "size": 3.0})
+	tags, _ := structpb.NewList([]interface{}{"a", true, nil})
+	document, err := DefaultCreateDocument(ctx, &Document{
+		Metadata: metadata,
+		Body:     structpb.NewStringValue("text"),
+		Tags:     tags,
+	}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	read, err := DefaultReadDocument(ctx, &Document{Id: document.Id}, db)
+	if err != nil || !proto.Equal(read, document) {
+		t.Fatalf("Expected document %v, got %v, %v", document, read, err)
+	}
+	var stored string
+	if err := db.Table("documents").Select("metadata").Where("id = ?", document.Id).Scan(&stored).Error; err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	var columns map[string]interface{}
+	if err := json.Unmarshal([]byte(stored), &columns); err != nil || columns["owner"] != "ann" || columns["size"] != 3.0 {
+		t.Errorf("Unexpected metadata column: %s, %v", stored, err)
+	}
+
+	patcher, _ := structpb.NewStruct(map[string]interface{}{"owner": "bob"})
+	mask := &field_mask.FieldMask{Paths: []string{"Metadata.owner"}}
+	patched, err := DefaultPatchDocument(ctx, &Document{Id: document.Id, Metadata: patcher}, mask, db)
+	if err != nil || !proto.Equal(patched.Metadata, patcher) || !proto.Equal(patched.Tags, tags) {
+		t.Errorf("Expected the metadata replaced as a whole, got %v, %v", patched, err)
+	}
+}
@@ -0,0 +1,934 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	gorm "gorm.io/gorm"
+	strings "strings"
+	time "time"
+)
+
+type StampORM struct {
+	Born      int32      `gorm:"autoCreateTime"`
+	CreatedAt *time.Time `gorm:"precision:3;autoCreateTime"`
+	Id        uint64
+	SeenAt    *time.Time `gorm:"precision:6;autoUpdateTime"`
+	Text      string
+	UpdatedAt int64 `gorm:"autoUpdateTime:milli"`
+}
+
+// TableName overrides the default table name generated by GORM
+func (StampORM) TableName() string {
+	return "stamps"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Stamp) ToORM(ctx context.Context) (StampORM, error) {
+	to := StampORM{}
+	var err error
+	if prehook, ok := interface{}(m).(StampWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if m.CreatedAt != nil {
+		if !m.CreatedAt.IsValid() {
+			return to, fmt.Errorf("CreatedAt invalid")
+		}
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	to.UpdatedAt = m.UpdatedAt
+	if m.SeenAt != nil {
+		if !m.SeenAt.IsValid() {
+			return to, fmt.Errorf("SeenAt invalid")
+		}
+		t := m.SeenAt.AsTime()
+		to.SeenAt = &t
+	}
+	to.Born = m.Born
+	if posthook, ok := interface{}(m).(StampWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *StampORM) ToPB(ctx context.Context) (Stamp, error) {
+	to := Stamp{}
+	var err error
+	if prehook, ok := interface{}(m).(StampWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	to.UpdatedAt = m.UpdatedAt
+	if m.SeenAt != nil {
+		to.SeenAt = timestamppb.New(*m.SeenAt)
+	}
+	to.Born = m.Born
+	if posthook, ok := interface{}(m).(StampWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Stamp the arg will be the target, the caller the one being converted from
+
+// StampWithBeforeToORM called before default ToORM code
+type StampWithBeforeToORM interface {
+	BeforeToORM(context.Context, *StampORM) error
+}
+
+// StampWithAfterToORM called after default ToORM code
+type StampWithAfterToORM interface {
+	AfterToORM(context.Context, *StampORM) error
+}
+
+// StampWithBeforeToPB called before default ToPB code
+type StampWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Stamp) error
+}
+
+// StampWithAfterToPB called after default ToPB code
+type StampWithAfterToPB interface {
+	AfterToPB(context.Context, *Stamp) error
+}
+
+type ClockORM struct {
+	Id      uint64
+	Started int64 `gorm:"autoCreateTime"`
+}
+
+// TableName overrides the default table name generated by GORM
+func (ClockORM) TableName() string {
+	return "clocks"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Clock) ToORM(ctx context.Context) (ClockORM, error) {
+	to := ClockORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ClockWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Started = m.Started
+	if posthook, ok := interface{}(m).(ClockWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ClockORM) ToPB(ctx context.Context) (Clock, error) {
+	to := Clock{}
+	var err error
+	if prehook, ok := interface{}(m).(ClockWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Started = m.Started
+	if posthook, ok := interface{}(m).(ClockWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Clock the arg will be the target, the caller the one being converted from
+
+// ClockWithBeforeToORM called before default ToORM code
+type ClockWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ClockORM) error
+}
+
+// ClockWithAfterToORM called after default ToORM code
+type ClockWithAfterToORM interface {
+	AfterToORM(context.Context, *ClockORM) error
+}
+
+// ClockWithBeforeToPB called before default ToPB code
+type ClockWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Clock) error
+}
+
+// ClockWithAfterToPB called after default ToPB code
+type ClockWithAfterToPB interface {
+	AfterToPB(context.Context, *Clock) error
+}
+
+// DefaultCreateStamp executes a basic gorm create call
+func DefaultCreateStamp(ctx context.Context, in *Stamp, db *gorm.DB) (*Stamp, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StampORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadStamp executes a basic gorm read call
+func DefaultReadStamp(ctx context.Context, in *Stamp, db *gorm.DB) (*Stamp, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &StampORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := StampORM{}
+	if err = db.Where(&StampORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(StampORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type StampORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteStamp(ctx context.Context, in *Stamp, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&StampORM{Id: ormObj.Id}).Delete(&StampORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type StampORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteStampSet(ctx context.Context, in []*Stamp, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&StampORM{})).(StampORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&StampORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&StampORM{})).(StampORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type StampORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Stamp, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Stamp, *gorm.DB) error
+}
+
+// DefaultStrictUpdateStamp clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateStamp(ctx context.Context, in *Stamp, db *gorm.DB) (*Stamp, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateStamp")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &StampORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	ormObj.Born = lockedRow.Born
+	ormObj.CreatedAt = lockedRow.CreatedAt
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type StampORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchStamp executes a basic gorm update call with patch behavior
+func DefaultPatchStamp(ctx context.Context, in *Stamp, updateMask *field_mask.FieldMask, db *gorm.DB) (*Stamp, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Stamp
+	var err error
+	if hook, ok := interface{}(&pbObj).(StampWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadStamp(ctx, &Stamp{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(StampWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskStamp(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(StampWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateStamp(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(StampWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type StampWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Stamp, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StampWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Stamp, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StampWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Stamp, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type StampWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Stamp, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetStamp executes a bulk gorm update call with patch behavior
+func DefaultPatchSetStamp(ctx context.Context, objects []*Stamp, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Stamp, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Stamp, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchStamp(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskStamp patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskStamp(ctx context.Context, patchee *Stamp, patcher *Stamp, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Stamp, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedSeenAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if f == prefix+"UpdatedAt" {
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if !updatedSeenAt && strings.HasPrefix(f, prefix+"SeenAt.") {
+			if patcher.SeenAt == nil {
+				patchee.SeenAt = nil
+				continue
+			}
+			if patchee.SeenAt == nil {
+				patchee.SeenAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"SeenAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.SeenAt, patchee.SeenAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"SeenAt" {
+			updatedSeenAt = true
+			patchee.SeenAt = patcher.SeenAt
+			continue
+		}
+		if f == prefix+"Born" {
+			patchee.Born = patcher.Born
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListStamp executes a gorm list call
+func DefaultListStamp(ctx context.Context, db *gorm.DB) ([]*Stamp, error) {
+	in := Stamp{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &StampORM{}, &Stamp{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []StampORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(StampORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Stamp{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type StampORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type StampORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]StampORM) error
+}
+
+// DefaultCreateClock executes a basic gorm create call
+func DefaultCreateClock(ctx context.Context, in *Clock, db *gorm.DB) (*Clock, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ClockORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadClock executes a basic gorm read call
+func DefaultReadClock(ctx context.Context, in *Clock, db *gorm.DB) (*Clock, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ClockORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ClockORM{}
+	if err = db.Where(&ClockORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ClockORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ClockORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteClock(ctx context.Context, in *Clock, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ClockORM{Id: ormObj.Id}).Delete(&ClockORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ClockORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteClockSet(ctx context.Context, in []*Clock, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ClockORM{})).(ClockORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ClockORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ClockORM{})).(ClockORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ClockORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Clock, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Clock, *gorm.DB) error
+}
+
+// DefaultStrictUpdateClock clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateClock(ctx context.Context, in *Clock, db *gorm.DB) (*Clock, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateClock")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ClockORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	ormObj.Started = lockedRow.Started
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ClockORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchClock executes a basic gorm update call with patch behavior
+func DefaultPatchClock(ctx context.Context, in *Clock, updateMask *field_mask.FieldMask, db *gorm.DB) (*Clock, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Clock
+	var err error
+	if hook, ok := interface{}(&pbObj).(ClockWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadClock(ctx, &Clock{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ClockWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskClock(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ClockWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateClock(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ClockWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ClockWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Clock, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ClockWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Clock, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ClockWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Clock, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ClockWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Clock, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetClock executes a bulk gorm update call with patch behavior
+func DefaultPatchSetClock(ctx context.Context, objects []*Clock, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Clock, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Clock, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchClock(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskClock patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskClock(ctx context.Context, patchee *Clock, patcher *Clock, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Clock, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Started" {
+			patchee.Started = patcher.Started
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListClock executes a gorm list call
+func DefaultListClock(ctx context.Context, db *gorm.DB) ([]*Clock, error) {
+	in := Clock{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ClockORM{}, &Clock{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []ClockORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClockORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Clock{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ClockORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClockORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ClockORM) error
+}
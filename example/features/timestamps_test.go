@@ -0,0 +1,44 @@
+package features
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimestamps(t *testing.T) {
+	db := openDB(t, &StampORM{}, &ClockORM{})
+	ctx := context.Background()
+	before := time.Now()
+	stamp, err := DefaultCreateStamp(ctx, &Stamp{Text: "a"}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if stamp.CreatedAt == nil || stamp.SeenAt == nil || stamp.UpdatedAt < before.UnixNano()/1e6 ||
+		stamp.UpdatedAt > time.Now().UnixNano()/1e6 || stamp.Born < int32(before.Unix()) {
+		t.Fatalf("Expected automatic times after %v, got %v", before, stamp)
+	}
+	created := stamp.CreatedAt.AsTime()
+	time.Sleep(2 * time.Millisecond)
+	updated, err := DefaultStrictUpdateStamp(ctx, &Stamp{Id: stamp.Id, Text: "b", CreatedAt: timestamppb.New(time.Unix(0, 0)), Born: 1}, db)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if !updated.CreatedAt.AsTime().Equal(created) || updated.Born != stamp.Born || updated.UpdatedAt <= stamp.UpdatedAt {
+		t.Fatalf("Expected kept creation times and a later update time, got %v after %v", updated, stamp)
+	}
+	read, err := DefaultReadStamp(ctx, &Stamp{Id: stamp.Id}, db)
+	if err != nil || read.Born != stamp.Born || read.CreatedAt.AsTime().Unix() != created.Unix() || read.Text != "b" {
+		t.Fatalf("Expected stamp b created at %v, got %v, %v", created, read, err)
+	}
+	if seen := read.SeenAt.AsTime(); seen.Before(created) || seen.After(time.Now()) {
+		t.Errorf("Expected stamp b seen after %v, got %v", created, seen)
+	}
+	// the strict update of a missing row creates it
+	clock, err := DefaultStrictUpdateClock(ctx, &Clock{Id: 7, Started: 1}, db)
+	if err != nil || clock.Started < before.Unix() {
+		t.Fatalf("Expected clock started after %v, got %v, %v", before, clock, err)
+	}
+}
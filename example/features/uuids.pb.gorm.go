@@ -0,0 +1,451 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	uuid "github.com/google/uuid"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	types "github.com/kirinse/protoc-gen-gorm/types"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	gorm "gorm.io/gorm"
+)
+
+type DeviceORM struct {
+	Id      uuid.UUID `gorm:"primaryKey"`
+	Name    string
+	OwnerId *uuid.UUID
+}
+
+// TableName overrides the default table name generated by GORM
+func (DeviceORM) TableName() string {
+	return "devices"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Device) ToORM(ctx context.Context) (DeviceORM, error) {
+	to := DeviceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(DeviceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if m.Id != nil {
+		to.Id, err = uuid.Parse(m.Id.Value)
+		if err != nil {
+			return to, err
+		}
+	} else {
+		to.Id = uuid.Nil
+	}
+	if m.OwnerId != nil {
+		tempUUID, uErr := uuid.Parse(m.OwnerId.Value)
+		if uErr != nil {
+			return to, uErr
+		}
+		to.OwnerId = &tempUUID
+	}
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(DeviceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *DeviceORM) ToPB(ctx context.Context) (Device, error) {
+	to := Device{}
+	var err error
+	if prehook, ok := interface{}(m).(DeviceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = &types.UUID{Value: m.Id.String()}
+	if m.OwnerId != nil {
+		to.OwnerId = &types.UUIDValue{Value: m.OwnerId.String()}
+	}
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(DeviceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Device the arg will be the target, the caller the one being converted from
+
+// DeviceWithBeforeToORM called before default ToORM code
+type DeviceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *DeviceORM) error
+}
+
+// DeviceWithAfterToORM called after default ToORM code
+type DeviceWithAfterToORM interface {
+	AfterToORM(context.Context, *DeviceORM) error
+}
+
+// DeviceWithBeforeToPB called before default ToPB code
+type DeviceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Device) error
+}
+
+// DeviceWithAfterToPB called after default ToPB code
+type DeviceWithAfterToPB interface {
+	AfterToPB(context.Context, *Device) error
+}
+
+// DefaultCreateDevice executes a basic gorm create call
+func DefaultCreateDevice(ctx context.Context, in *Device, db *gorm.DB) (*Device, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DeviceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadDevice executes a basic gorm read call
+func DefaultReadDevice(ctx context.Context, in *Device, db *gorm.DB) (*Device, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &DeviceORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := DeviceORM{}
+	if err = db.Where(&DeviceORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(DeviceORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type DeviceORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDevice(ctx context.Context, in *Device, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == uuid.Nil {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&DeviceORM{Id: ormObj.Id}).Delete(&DeviceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type DeviceORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteDeviceSet(ctx context.Context, in []*Device, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uuid.UUID{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == uuid.Nil {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&DeviceORM{})).(DeviceORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&DeviceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&DeviceORM{})).(DeviceORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type DeviceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Device, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Device, *gorm.DB) error
+}
+
+// DefaultStrictUpdateDevice clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateDevice(ctx context.Context, in *Device, db *gorm.DB) (*Device, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateDevice")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &DeviceORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type DeviceORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchDevice executes a basic gorm update call with patch behavior
+func DefaultPatchDevice(ctx context.Context, in *Device, updateMask *field_mask.FieldMask, db *gorm.DB) (*Device, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Device
+	var err error
+	if hook, ok := interface{}(&pbObj).(DeviceWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadDevice(ctx, &Device{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(DeviceWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskDevice(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(DeviceWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateDevice(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(DeviceWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type DeviceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Device, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Device, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Device, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Device, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetDevice executes a bulk gorm update call with patch behavior
+func DefaultPatchSetDevice(ctx context.Context, objects []*Device, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Device, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Device, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchDevice(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskDevice patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskDevice(ctx context.Context, patchee *Device, patcher *Device, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Device, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"OwnerId" {
+			patchee.OwnerId = patcher.OwnerId
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListDevice executes a gorm list call
+func DefaultListDevice(ctx context.Context, db *gorm.DB) ([]*Device, error) {
+	in := Device{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &DeviceORM{}, &Device{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []DeviceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DeviceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Device{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type DeviceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type DeviceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]DeviceORM) error
+}
@@ -0,0 +1,40 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kirinse/protoc-gen-gorm/errors"
+	"github.com/kirinse/protoc-gen-gorm/types"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUUIDs(t *testing.T) {
+	db := openDB(t, &DeviceORM{})
+	ctx := context.Background()
+	id, owner := uuid.New(), uuid.New()
+	for _, device := range []*Device{
+		{Id: &types.UUID{Value: id.String()}, OwnerId: &types.UUIDValue{Value: owner.String()}, Name: "a"},
+		{Id: &types.UUID{Value: uuid.New().String()}, Name: "b"},
+	} {
+		created, err := DefaultCreateDevice(ctx, device, db)
+		if err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+		read, err := DefaultReadDevice(ctx, &Device{Id: device.Id}, db)
+		if err != nil || !proto.Equal(read, created) {
+			t.Errorf("Expected device %v, got %v, %v", created, read, err)
+		}
+	}
+	var stored string
+	if err := db.Table("devices").Select("owner_id").Where("name = ?", "a").Scan(&stored).Error; err != nil || stored != owner.String() {
+		t.Errorf("Expected owner %s, got %s, %v", owner, stored, err)
+	}
+	if _, err := DefaultReadDevice(ctx, &Device{}, db); err != errors.EmptyIdError {
+		t.Errorf("Expected %s reading a nil id, got %v", errors.EmptyIdError, err)
+	}
+	if _, err := DefaultCreateDevice(ctx, &Device{Id: &types.UUID{Value: "bad"}}, db); err == nil {
+		t.Errorf("Expected an error creating a device of a malformed id")
+	}
+}
@@ -0,0 +1,952 @@
+package features
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	gorm "gorm.io/gorm"
+)
+
+type LedgerORM struct {
+	Entries []*EntryORM `gorm:"foreignKey:LedgerId;references:Id"`
+	Id      uint64
+	Title   string
+	Version int64
+}
+
+// TableName overrides the default table name generated by GORM
+func (LedgerORM) TableName() string {
+	return "ledgers"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Ledger) ToORM(ctx context.Context) (LedgerORM, error) {
+	to := LedgerORM{}
+	var err error
+	if prehook, ok := interface{}(m).(LedgerWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	to.Version = m.Version
+	for _, v := range m.Entries {
+		if v != nil {
+			if tempEntries, cErr := v.ToORM(ctx); cErr == nil {
+				to.Entries = append(to.Entries, &tempEntries)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Entries = append(to.Entries, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(LedgerWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *LedgerORM) ToPB(ctx context.Context) (Ledger, error) {
+	to := Ledger{}
+	var err error
+	if prehook, ok := interface{}(m).(LedgerWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	to.Version = m.Version
+	for _, v := range m.Entries {
+		if v != nil {
+			if tempEntries, cErr := v.ToPB(ctx); cErr == nil {
+				to.Entries = append(to.Entries, &tempEntries)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Entries = append(to.Entries, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(LedgerWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Ledger the arg will be the target, the caller the one being converted from
+
+// LedgerWithBeforeToORM called before default ToORM code
+type LedgerWithBeforeToORM interface {
+	BeforeToORM(context.Context, *LedgerORM) error
+}
+
+// LedgerWithAfterToORM called after default ToORM code
+type LedgerWithAfterToORM interface {
+	AfterToORM(context.Context, *LedgerORM) error
+}
+
+// LedgerWithBeforeToPB called before default ToPB code
+type LedgerWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Ledger) error
+}
+
+// LedgerWithAfterToPB called after default ToPB code
+type LedgerWithAfterToPB interface {
+	AfterToPB(context.Context, *Ledger) error
+}
+
+type EntryORM struct {
+	Id       uint64
+	LedgerId *uint64
+	Note     string
+}
+
+// TableName overrides the default table name generated by GORM
+func (EntryORM) TableName() string {
+	return "entries"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Entry) ToORM(ctx context.Context) (EntryORM, error) {
+	to := EntryORM{}
+	var err error
+	if prehook, ok := interface{}(m).(EntryWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Note = m.Note
+	if posthook, ok := interface{}(m).(EntryWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *EntryORM) ToPB(ctx context.Context) (Entry, error) {
+	to := Entry{}
+	var err error
+	if prehook, ok := interface{}(m).(EntryWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Note = m.Note
+	if posthook, ok := interface{}(m).(EntryWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Entry the arg will be the target, the caller the one being converted from
+
+// EntryWithBeforeToORM called before default ToORM code
+type EntryWithBeforeToORM interface {
+	BeforeToORM(context.Context, *EntryORM) error
+}
+
+// EntryWithAfterToORM called after default ToORM code
+type EntryWithAfterToORM interface {
+	AfterToORM(context.Context, *EntryORM) error
+}
+
+// EntryWithBeforeToPB called before default ToPB code
+type EntryWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Entry) error
+}
+
+// EntryWithAfterToPB called after default ToPB code
+type EntryWithAfterToPB interface {
+	AfterToPB(context.Context, *Entry) error
+}
+
+// DefaultCreateLedger executes a basic gorm create call
+func DefaultCreateLedger(ctx context.Context, in *Ledger, db *gorm.DB) (*Ledger, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LedgerORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadLedger executes a basic gorm read call
+func DefaultReadLedger(ctx context.Context, in *Ledger, db *gorm.DB) (*Ledger, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LedgerORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := LedgerORM{}
+	if err = db.Where(&LedgerORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(LedgerORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LedgerORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLedger(ctx context.Context, in *Ledger, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&LedgerORM{Id: ormObj.Id}).Delete(&LedgerORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type LedgerORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLedgerSet(ctx context.Context, in []*Ledger, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&LedgerORM{})).(LedgerORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&LedgerORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&LedgerORM{})).(LedgerORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type LedgerORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Ledger, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Ledger, *gorm.DB) error
+}
+
+// DefaultStrictUpdateLedger clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLedger(ctx context.Context, in *Ledger, db *gorm.DB) (*Ledger, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateLedger")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &LedgerORM{}
+	count = db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if count != 0 && lockedRow.Version != ormObj.Version {
+		return nil, &errors.VersionConflictError{Type: "Ledger", Version: int64(ormObj.Version)}
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterEntries := EntryORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterEntries.LedgerId = new(uint64)
+	*filterEntries.LedgerId = ormObj.Id
+	if err = db.Where(filterEntries).Delete(EntryORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if count == 0 {
+		if err = db.Save(&ormObj).Error; err != nil {
+			return nil, err
+		}
+	} else {
+		version := ormObj.Version
+		ormObj.Version++
+		res := db.Model(&ormObj).Select("*").Where("version = ?", version).Updates(&ormObj)
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil, &errors.VersionConflictError{Type: "Ledger", Version: int64(version)}
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type LedgerORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchLedger executes a basic gorm update call with patch behavior
+func DefaultPatchLedger(ctx context.Context, in *Ledger, updateMask *field_mask.FieldMask, db *gorm.DB) (*Ledger, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Ledger
+	var err error
+	if hook, ok := interface{}(&pbObj).(LedgerWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadLedger(ctx, &Ledger{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(LedgerWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskLedger(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	pbObj.Version = in.Version
+	if hook, ok := interface{}(&pbObj).(LedgerWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateLedger(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(LedgerWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type LedgerWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Ledger, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Ledger, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Ledger, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Ledger, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetLedger executes a bulk gorm update call with patch behavior
+func DefaultPatchSetLedger(ctx context.Context, objects []*Ledger, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Ledger, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Ledger, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchLedger(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskLedger patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLedger(ctx context.Context, patchee *Ledger, patcher *Ledger, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Ledger, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"Version" {
+			patchee.Version = patcher.Version
+			continue
+		}
+		if f == prefix+"Entries" {
+			patchee.Entries = patcher.Entries
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListLedger executes a gorm list call
+func DefaultListLedger(ctx context.Context, db *gorm.DB) ([]*Ledger, error) {
+	in := Ledger{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LedgerORM{}, &Ledger{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []LedgerORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Ledger{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type LedgerORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LedgerORM) error
+}
+
+// DefaultCreateEntry executes a basic gorm create call
+func DefaultCreateEntry(ctx context.Context, in *Entry, db *gorm.DB) (*Entry, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EntryORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultReadEntry executes a basic gorm read call
+func DefaultReadEntry(ctx context.Context, in *Entry, db *gorm.DB) (*Entry, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &EntryORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := EntryORM{}
+	if err = db.Where(&EntryORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(EntryORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EntryORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteEntry(ctx context.Context, in *Entry, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&EntryORM{Id: ormObj.Id}).Delete(&EntryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type EntryORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteEntrySet(ctx context.Context, in []*Entry, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&EntryORM{})).(EntryORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&EntryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&EntryORM{})).(EntryORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type EntryORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Entry, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Entry, *gorm.DB) error
+}
+
+// DefaultStrictUpdateEntry clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateEntry(ctx context.Context, in *Entry, db *gorm.DB) (*Entry, error) {
+	if in == nil {
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateEntry")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &EntryORM{}
+	db.Model(&ormObj).Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type EntryORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchEntry executes a basic gorm update call with patch behavior
+func DefaultPatchEntry(ctx context.Context, in *Entry, updateMask *field_mask.FieldMask, db *gorm.DB) (*Entry, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Entry
+	var err error
+	if hook, ok := interface{}(&pbObj).(EntryWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadEntry(ctx, &Entry{Id: in.Id}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(EntryWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskEntry(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(EntryWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateEntry(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(EntryWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type EntryWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Entry, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EntryWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Entry, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EntryWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Entry, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EntryWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Entry, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetEntry executes a bulk gorm update call with patch behavior
+func DefaultPatchSetEntry(ctx context.Context, objects []*Entry, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Entry, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Entry, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchEntry(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskEntry patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskEntry(ctx context.Context, patchee *Entry, patcher *Entry, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Entry, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Note" {
+			patchee.Note = patcher.Note
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListEntry executes a gorm list call
+func DefaultListEntry(ctx context.Context, db *gorm.DB) ([]*Entry, error) {
+	in := Entry{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EntryORM{}, &Entry{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Order("id")
+	ormResponse := []EntryORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EntryORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Entry{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type EntryORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EntryORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]EntryORM) error
+}
+type LedgersDefaultServer struct {
+	DB *gorm.DB
+}
+
+// UpdateLedger ...
+func (m *LedgersDefaultServer) UpdateLedger(ctx context.Context, in *UpdateLedgerRequest) (*UpdateLedgerResponse, error) {
+	var err error
+	var res *Ledger
+	db := m.DB
+	if custom, ok := interface{}(in).(LedgersLedgerWithBeforeUpdateLedger); ok {
+		var err error
+		if db, err = custom.BeforeUpdateLedger(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if in.GetFields() == nil {
+		res, err = DefaultStrictUpdateLedger(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultPatchLedger(ctx, in.GetPayload(), in.GetFields(), db)
+	}
+	if err != nil {
+		if errors.IsVersionConflict(err) {
+			err = status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+	out := &UpdateLedgerResponse{Result: res}
+	if custom, ok := interface{}(in).(LedgersLedgerWithAfterUpdateLedger); ok {
+		var err error
+		if err = custom.AfterUpdateLedger(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// LedgersLedgerWithBeforeUpdateLedger called before DefaultStrictUpdateLedger or DefaultPatchLedger in the default UpdateLedger handler
+type LedgersLedgerWithBeforeUpdateLedger interface {
+	BeforeUpdateLedger(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// LedgersLedgerWithAfterUpdateLedger called after DefaultStrictUpdateLedger or DefaultPatchLedger in the default UpdateLedger handler
+type LedgersLedgerWithAfterUpdateLedger interface {
+	AfterUpdateLedger(context.Context, *UpdateLedgerResponse, *gorm.DB) error
+}
@@ -0,0 +1,56 @@
+package features
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirinse/protoc-gen-gorm/errors"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestVersion(t *testing.T) {
+	db := openDB(t, &LedgerORM{}, &EntryORM{})
+	ctx := context.Background()
+	ledger, err := DefaultStrictUpdateLedger(ctx, &Ledger{Id: 1, Title: "a"}, db)
+	if err != nil || ledger.Version != 0 {
+		t.Fatalf("Expected created ledger at version 0, got %v, %v", ledger, err)
+	}
+	ledger, err = DefaultStrictUpdateLedger(ctx, &Ledger{Id: 1, Title: "b", Version: 0}, db)
+	if err != nil || ledger.Version != 1 || ledger.Title != "b" {
+		t.Fatalf("Expected ledger b at version 1, got %v, %v", ledger, err)
+	}
+	if _, err := DefaultStrictUpdateLedger(ctx, &Ledger{Id: 1, Title: "c", Version: 0}, db); !errors.IsVersionConflict(err) {
+		t.Errorf("Expected version conflict of a stale update, got %v", err)
+	}
+	mask := &field_mask.FieldMask{Paths: []string{"Title"}}
+	if _, err := DefaultPatchLedger(ctx, &Ledger{Id: 1, Title: "d"}, mask, db); !errors.IsVersionConflict(err) {
+		t.Errorf("Expected version conflict of a stale patch, got %v", err)
+	}
+	ledger, err = DefaultPatchLedger(ctx, &Ledger{Id: 1, Title: "d", Version: 1}, mask, db)
+	if err != nil || ledger.Version != 2 || ledger.Title != "d" {
+		t.Fatalf("Expected ledger d at version 2, got %v, %v", ledger, err)
+	}
+	// another writer updates the row between the read and the update
+	db.Callback().Update().Before("gorm:update").Register("concurrent", func(tx *gorm.DB) {
+		if _, err := tx.Statement.ConnPool.ExecContext(ctx, "UPDATE ledgers SET version = 9"); err != nil {
+			t.Fatalf("Got unexpected error: %s", err)
+		}
+	})
+	_, err = DefaultStrictUpdateLedger(ctx, &Ledger{Id: 1, Title: "e", Version: 2}, db)
+	db.Callback().Update().Remove("concurrent")
+	if !errors.IsVersionConflict(err) {
+		t.Errorf("Expected version conflict of a concurrent update, got %v", err)
+	}
+	server := &LedgersDefaultServer{DB: db}
+	_, err = server.UpdateLedger(ctx, &UpdateLedgerRequest{Payload: &Ledger{Id: 1, Title: "f", Version: 2}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected %s status, got %v", codes.FailedPrecondition, err)
+	}
+	res, err := server.UpdateLedger(ctx, &UpdateLedgerRequest{Payload: &Ledger{Id: 1, Title: "f", Version: 9}, Fields: mask})
+	if err != nil || res.Result.Version != 10 {
+		t.Fatalf("Expected ledger at version 10, got %v, %v", res, err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	pq "github.com/lib/pq"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
 )
 
 type ExampleORM struct {
@@ -16,10 +17,10 @@ type ExampleORM struct {
 	ArrayOfInt64   pq.Int64Array   `gorm:"type:integer[]"`
 	ArrayOfString  pq.StringArray  `gorm:"type:text[]"`
 	Description    string
-	Id             string `gorm:"type:uuid;primary_key"`
+	Id             string `gorm:"type:uuid;primaryKey"`
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (ExampleORM) TableName() string {
 	return "examples"
 }
@@ -95,22 +96,22 @@ func (m *ExampleORM) ToPB(ctx context.Context) (Example, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Example the arg will be the target, the caller the one being converted from
 
-// ExampleBeforeToORM called before default ToORM code
+// ExampleWithBeforeToORM called before default ToORM code
 type ExampleWithBeforeToORM interface {
 	BeforeToORM(context.Context, *ExampleORM) error
 }
 
-// ExampleAfterToORM called after default ToORM code
+// ExampleWithAfterToORM called after default ToORM code
 type ExampleWithAfterToORM interface {
 	AfterToORM(context.Context, *ExampleORM) error
 }
 
-// ExampleBeforeToPB called before default ToPB code
+// ExampleWithBeforeToPB called before default ToPB code
 type ExampleWithBeforeToPB interface {
 	BeforeToPB(context.Context, *Example) error
 }
 
-// ExampleAfterToPB called after default ToPB code
+// ExampleWithAfterToPB called after default ToPB code
 type ExampleWithAfterToPB interface {
 	AfterToPB(context.Context, *Example) error
 }
@@ -174,7 +175,7 @@ func DefaultReadExample(ctx context.Context, in *Example, db *gorm.DB) (*Example
 		}
 	}
 	ormResponse := ExampleORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&ExampleORM{Id: ormObj.Id}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(ExampleORMWithAfterReadFind); ok {
@@ -212,7 +213,7 @@ func DefaultDeleteExample(ctx context.Context, in *Example, db *gorm.DB) error {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&ExampleORM{}).Error
+	err = db.Where(&ExampleORM{Id: ormObj.Id}).Delete(&ExampleORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -270,14 +271,14 @@ type ExampleORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateExample clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateExample(ctx context.Context, in *Example, db *gorm.DB) (*Example, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExample")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateExample")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	lockedRow := &ExampleORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -325,7 +326,7 @@ func DefaultPatchExample(ctx context.Context, in *Example, updateMask *field_mas
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadExample(ctx, &Example{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadExample(ctx, &Example{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -448,7 +449,6 @@ func DefaultListExample(ctx context.Context, db *gorm.DB) ([]*Example, error) {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
 	db = db.Order("id")
 	ormResponse := []ExampleORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -3,37 +3,38 @@ package user
 import (
 	context "context"
 	fmt "fmt"
-	errors "github.com/kirinse/protoc-gen-gorm/errors"
-	ptypes "github.com/golang/protobuf/ptypes"
 	auth "github.com/kirinse/atlas-app-toolkit/auth"
 	gorm1 "github.com/kirinse/atlas-app-toolkit/gorm"
 	resource "github.com/kirinse/atlas-app-toolkit/gorm/resource"
+	errors "github.com/kirinse/protoc-gen-gorm/errors"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	gorm "gorm.io/gorm"
+	clause "gorm.io/gorm/clause"
 	strings "strings"
 	time "time"
 )
 
 type UserORM struct {
 	AccountID         string
-	BillingAddress    *AddressORM `gorm:"foreignkey:BillingAddressId;association_foreignkey:Id"`
+	BillingAddress    *AddressORM `gorm:"foreignKey:BillingAddressId;references:Id"`
 	BillingAddressId  *int64
 	Birthday          *time.Time
 	CreatedAt         *time.Time
-	CreditCard        *CreditCardORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
-	Emails            []*EmailORM    `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	CreditCard        *CreditCardORM `gorm:"foreignKey:UserId;references:Id"`
+	Emails            []*EmailORM    `gorm:"foreignKey:UserId;references:Id"`
 	ExternalUuid      *string        `gorm:"type:uuid"`
-	Friends           []*UserORM     `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_friends;jointable_foreignkey:user_id;association_jointable_foreignkey:friend_id"`
-	Id                string         `gorm:"type:uuid;primary_key"`
-	Languages         []*LanguageORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_languages;jointable_foreignkey:user_id;association_jointable_foreignkey:language_id"`
+	Friends           []*UserORM     `gorm:"foreignKey:Id;references:Id;many2many:user_friends;joinForeignKey:UserId;joinReferences:FriendId"`
+	Id                string         `gorm:"type:uuid;primaryKey"`
+	Languages         []*LanguageORM `gorm:"foreignKey:Id;references:Id;many2many:user_languages;joinForeignKey:UserId;joinReferences:LanguageId"`
 	Num               uint32
-	ShippingAddress   *AddressORM `gorm:"foreignkey:ShippingAddressId;association_foreignkey:Id"`
+	ShippingAddress   *AddressORM `gorm:"foreignKey:ShippingAddressId;references:Id"`
 	ShippingAddressId *int64
-	Tasks             []*TaskORM `gorm:"foreignkey:UserId;association_foreignkey:Id" atlas:"position:Priority"`
+	Tasks             []*TaskORM `gorm:"foreignKey:UserId;references:Id" atlas:"position:Priority"`
 	UpdatedAt         *time.Time
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (UserORM) TableName() string {
 	return "users"
 }
@@ -54,24 +55,24 @@ func (m *User) ToORM(ctx context.Context) (UserORM, error) {
 		to.Id = v.(string)
 	}
 	if m.CreatedAt != nil {
-		var t time.Time
-		if t, err = ptypes.Timestamp(m.CreatedAt); err != nil {
-			return to, err
+		if !m.CreatedAt.IsValid() {
+			return to, fmt.Errorf("CreatedAt invalid")
 		}
+		t := m.CreatedAt.AsTime()
 		to.CreatedAt = &t
 	}
 	if m.UpdatedAt != nil {
-		var t time.Time
-		if t, err = ptypes.Timestamp(m.UpdatedAt); err != nil {
-			return to, err
+		if !m.UpdatedAt.IsValid() {
+			return to, fmt.Errorf("UpdatedAt invalid")
 		}
+		t := m.UpdatedAt.AsTime()
 		to.UpdatedAt = &t
 	}
 	if m.Birthday != nil {
-		var t time.Time
-		if t, err = ptypes.Timestamp(m.Birthday); err != nil {
-			return to, err
+		if !m.Birthday.IsValid() {
+			return to, fmt.Errorf("Birthday invalid")
 		}
+		t := m.Birthday.AsTime()
 		to.Birthday = &t
 	}
 	to.Num = m.Num
@@ -185,19 +186,13 @@ func (m *UserORM) ToPB(ctx context.Context) (User, error) {
 		to.Id = v
 	}
 	if m.CreatedAt != nil {
-		if to.CreatedAt, err = ptypes.TimestampProto(*m.CreatedAt); err != nil {
-			return to, err
-		}
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
 	}
 	if m.UpdatedAt != nil {
-		if to.UpdatedAt, err = ptypes.TimestampProto(*m.UpdatedAt); err != nil {
-			return to, err
-		}
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
 	}
 	if m.Birthday != nil {
-		if to.Birthday, err = ptypes.TimestampProto(*m.Birthday); err != nil {
-			return to, err
-		}
+		to.Birthday = timestamppb.New(*m.Birthday)
 	}
 	to.Num = m.Num
 	if m.CreditCard != nil {
@@ -288,22 +283,22 @@ func (m *UserORM) ToPB(ctx context.Context) (User, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type User the arg will be the target, the caller the one being converted from
 
-// UserBeforeToORM called before default ToORM code
+// UserWithBeforeToORM called before default ToORM code
 type UserWithBeforeToORM interface {
 	BeforeToORM(context.Context, *UserORM) error
 }
 
-// UserAfterToORM called after default ToORM code
+// UserWithAfterToORM called after default ToORM code
 type UserWithAfterToORM interface {
 	AfterToORM(context.Context, *UserORM) error
 }
 
-// UserBeforeToPB called before default ToPB code
+// UserWithBeforeToPB called before default ToPB code
 type UserWithBeforeToPB interface {
 	BeforeToPB(context.Context, *User) error
 }
 
-// UserAfterToPB called after default ToPB code
+// UserWithAfterToPB called after default ToPB code
 type UserWithAfterToPB interface {
 	AfterToPB(context.Context, *User) error
 }
@@ -312,12 +307,12 @@ type EmailORM struct {
 	AccountID       string
 	Email           string
 	ExternalNotNull string `gorm:"type:uuid;not null"`
-	Id              string `gorm:"type:uuid;primary_key"`
+	Id              string `gorm:"type:uuid;primaryKey"`
 	Subscribed      bool
 	UserId          *string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (EmailORM) TableName() string {
 	return "emails"
 }
@@ -401,22 +396,22 @@ func (m *EmailORM) ToPB(ctx context.Context) (Email, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Email the arg will be the target, the caller the one being converted from
 
-// EmailBeforeToORM called before default ToORM code
+// EmailWithBeforeToORM called before default ToORM code
 type EmailWithBeforeToORM interface {
 	BeforeToORM(context.Context, *EmailORM) error
 }
 
-// EmailAfterToORM called after default ToORM code
+// EmailWithAfterToORM called after default ToORM code
 type EmailWithAfterToORM interface {
 	AfterToORM(context.Context, *EmailORM) error
 }
 
-// EmailBeforeToPB called before default ToPB code
+// EmailWithBeforeToPB called before default ToPB code
 type EmailWithBeforeToPB interface {
 	BeforeToPB(context.Context, *Email) error
 }
 
-// EmailAfterToPB called after default ToPB code
+// EmailWithAfterToPB called after default ToPB code
 type EmailWithAfterToPB interface {
 	AfterToPB(context.Context, *Email) error
 }
@@ -426,12 +421,12 @@ type AddressORM struct {
 	Address_1  string
 	Address_2  string
 	External   []byte  `gorm:"type:jsonb"`
-	Id         int64   `gorm:"type:integer;primary_key"`
+	Id         int64   `gorm:"type:integer;primaryKey"`
 	ImplicitFk *string `gorm:"type:text"`
 	Post       string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (AddressORM) TableName() string {
 	return "addresses"
 }
@@ -517,22 +512,22 @@ func (m *AddressORM) ToPB(ctx context.Context) (Address, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Address the arg will be the target, the caller the one being converted from
 
-// AddressBeforeToORM called before default ToORM code
+// AddressWithBeforeToORM called before default ToORM code
 type AddressWithBeforeToORM interface {
 	BeforeToORM(context.Context, *AddressORM) error
 }
 
-// AddressAfterToORM called after default ToORM code
+// AddressWithAfterToORM called after default ToORM code
 type AddressWithAfterToORM interface {
 	AfterToORM(context.Context, *AddressORM) error
 }
 
-// AddressBeforeToPB called before default ToPB code
+// AddressWithBeforeToPB called before default ToPB code
 type AddressWithBeforeToPB interface {
 	BeforeToPB(context.Context, *Address) error
 }
 
-// AddressAfterToPB called after default ToPB code
+// AddressWithAfterToPB called after default ToPB code
 type AddressWithAfterToPB interface {
 	AfterToPB(context.Context, *Address) error
 }
@@ -541,11 +536,11 @@ type LanguageORM struct {
 	AccountID   string
 	Code        string
 	ExternalInt *int64 `gorm:"type:integer"`
-	Id          int64  `gorm:"type:integer;primary_key"`
+	Id          int64  `gorm:"type:integer;primaryKey"`
 	Name        string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (LanguageORM) TableName() string {
 	return "languages"
 }
@@ -618,22 +613,22 @@ func (m *LanguageORM) ToPB(ctx context.Context) (Language, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Language the arg will be the target, the caller the one being converted from
 
-// LanguageBeforeToORM called before default ToORM code
+// LanguageWithBeforeToORM called before default ToORM code
 type LanguageWithBeforeToORM interface {
 	BeforeToORM(context.Context, *LanguageORM) error
 }
 
-// LanguageAfterToORM called after default ToORM code
+// LanguageWithAfterToORM called after default ToORM code
 type LanguageWithAfterToORM interface {
 	AfterToORM(context.Context, *LanguageORM) error
 }
 
-// LanguageBeforeToPB called before default ToPB code
+// LanguageWithBeforeToPB called before default ToPB code
 type LanguageWithBeforeToPB interface {
 	BeforeToPB(context.Context, *Language) error
 }
 
-// LanguageAfterToPB called after default ToPB code
+// LanguageWithAfterToPB called after default ToPB code
 type LanguageWithAfterToPB interface {
 	AfterToPB(context.Context, *Language) error
 }
@@ -641,13 +636,13 @@ type LanguageWithAfterToPB interface {
 type CreditCardORM struct {
 	AccountID string
 	CreatedAt *time.Time
-	Id        int64 `gorm:"type:integer;primary_key"`
+	Id        int64 `gorm:"type:integer;primaryKey"`
 	Number    string
 	UpdatedAt *time.Time
 	UserId    *string
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (CreditCardORM) TableName() string {
 	return "credit_cards"
 }
@@ -668,17 +663,17 @@ func (m *CreditCard) ToORM(ctx context.Context) (CreditCardORM, error) {
 		to.Id = v
 	}
 	if m.CreatedAt != nil {
-		var t time.Time
-		if t, err = ptypes.Timestamp(m.CreatedAt); err != nil {
-			return to, err
+		if !m.CreatedAt.IsValid() {
+			return to, fmt.Errorf("CreatedAt invalid")
 		}
+		t := m.CreatedAt.AsTime()
 		to.CreatedAt = &t
 	}
 	if m.UpdatedAt != nil {
-		var t time.Time
-		if t, err = ptypes.Timestamp(m.UpdatedAt); err != nil {
-			return to, err
+		if !m.UpdatedAt.IsValid() {
+			return to, fmt.Errorf("UpdatedAt invalid")
 		}
+		t := m.UpdatedAt.AsTime()
 		to.UpdatedAt = &t
 	}
 	to.Number = m.Number
@@ -717,14 +712,10 @@ func (m *CreditCardORM) ToPB(ctx context.Context) (CreditCard, error) {
 		to.Id = v
 	}
 	if m.CreatedAt != nil {
-		if to.CreatedAt, err = ptypes.TimestampProto(*m.CreatedAt); err != nil {
-			return to, err
-		}
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
 	}
 	if m.UpdatedAt != nil {
-		if to.UpdatedAt, err = ptypes.TimestampProto(*m.UpdatedAt); err != nil {
-			return to, err
-		}
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
 	}
 	to.Number = m.Number
 	if m.UserId != nil {
@@ -743,22 +734,22 @@ func (m *CreditCardORM) ToPB(ctx context.Context) (CreditCard, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type CreditCard the arg will be the target, the caller the one being converted from
 
-// CreditCardBeforeToORM called before default ToORM code
+// CreditCardWithBeforeToORM called before default ToORM code
 type CreditCardWithBeforeToORM interface {
 	BeforeToORM(context.Context, *CreditCardORM) error
 }
 
-// CreditCardAfterToORM called after default ToORM code
+// CreditCardWithAfterToORM called after default ToORM code
 type CreditCardWithAfterToORM interface {
 	AfterToORM(context.Context, *CreditCardORM) error
 }
 
-// CreditCardBeforeToPB called before default ToPB code
+// CreditCardWithBeforeToPB called before default ToPB code
 type CreditCardWithBeforeToPB interface {
 	BeforeToPB(context.Context, *CreditCard) error
 }
 
-// CreditCardAfterToPB called after default ToPB code
+// CreditCardWithAfterToPB called after default ToPB code
 type CreditCardWithAfterToPB interface {
 	AfterToPB(context.Context, *CreditCard) error
 }
@@ -771,7 +762,7 @@ type TaskORM struct {
 	UserId      string `gorm:"not null"`
 }
 
-// TableName overrides the default tablename generated by GORM
+// TableName overrides the default table name generated by GORM
 func (TaskORM) TableName() string {
 	return "tasks"
 }
@@ -822,22 +813,22 @@ func (m *TaskORM) ToPB(ctx context.Context) (Task, error) {
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Task the arg will be the target, the caller the one being converted from
 
-// TaskBeforeToORM called before default ToORM code
+// TaskWithBeforeToORM called before default ToORM code
 type TaskWithBeforeToORM interface {
 	BeforeToORM(context.Context, *TaskORM) error
 }
 
-// TaskAfterToORM called after default ToORM code
+// TaskWithAfterToORM called after default ToORM code
 type TaskWithAfterToORM interface {
 	AfterToORM(context.Context, *TaskORM) error
 }
 
-// TaskBeforeToPB called before default ToPB code
+// TaskWithBeforeToPB called before default ToPB code
 type TaskWithBeforeToPB interface {
 	BeforeToPB(context.Context, *Task) error
 }
 
-// TaskAfterToPB called after default ToPB code
+// TaskWithAfterToPB called after default ToPB code
 type TaskWithAfterToPB interface {
 	AfterToPB(context.Context, *Task) error
 }
@@ -901,7 +892,7 @@ func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error)
 		}
 	}
 	ormResponse := UserORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
@@ -939,7 +930,7 @@ func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&UserORM{}).Error
+	err = db.Where(&UserORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).Delete(&UserORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -1001,7 +992,7 @@ type UserORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateUser")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1013,7 +1004,8 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	lockedRow := &UserORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow)
+	ormObj.CreatedAt = lockedRow.CreatedAt
 	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -1095,7 +1087,7 @@ func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.Fiel
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -1165,6 +1157,9 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 		return nil, errors.NilArgumentError
 	}
 	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedBirthday bool
 	var updatedCreditCard bool
 	var updatedBillingAddress bool
 	var updatedShippingAddress bool
@@ -1173,15 +1168,72 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 			patchee.Id = patcher.Id
 			continue
 		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
 		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
 			patchee.CreatedAt = patcher.CreatedAt
 			continue
 		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
 		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
+		if !updatedBirthday && strings.HasPrefix(f, prefix+"Birthday.") {
+			if patcher.Birthday == nil {
+				patchee.Birthday = nil
+				continue
+			}
+			if patchee.Birthday == nil {
+				patchee.Birthday = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Birthday."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Birthday, patchee.Birthday, childMask); err != nil {
+				return nil, nil
+			}
+		}
 		if f == prefix+"Birthday" {
+			updatedBirthday = true
 			patchee.Birthday = patcher.Birthday
 			continue
 		}
@@ -1308,7 +1360,7 @@ func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&UserORM{AccountID: ormObj.AccountID})
 	db = db.Order("id")
 	ormResponse := []UserORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -1399,7 +1451,7 @@ func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, erro
 		}
 	}
 	ormResponse := EmailORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&EmailORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(EmailORMWithAfterReadFind); ok {
@@ -1437,7 +1489,7 @@ func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&EmailORM{}).Error
+	err = db.Where(&EmailORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).Delete(&EmailORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -1499,7 +1551,7 @@ type EmailORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateEmail clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateEmail")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateEmail")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1511,7 +1563,7 @@ func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Ema
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	lockedRow := &EmailORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -1559,7 +1611,7 @@ func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.Fi
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -1678,7 +1730,7 @@ func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&EmailORM{AccountID: ormObj.AccountID})
 	db = db.Order("id")
 	ormResponse := []EmailORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -1769,7 +1821,7 @@ func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address
 		}
 	}
 	ormResponse := AddressORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&AddressORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
@@ -1807,7 +1859,7 @@ func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&AddressORM{}).Error
+	err = db.Where(&AddressORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).Delete(&AddressORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -1869,7 +1921,7 @@ type AddressORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAddress")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateAddress")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1881,7 +1933,7 @@ func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	lockedRow := &AddressORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -1929,7 +1981,7 @@ func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mas
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2052,7 +2104,7 @@ func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&AddressORM{AccountID: ormObj.AccountID})
 	db = db.Order("id")
 	ormResponse := []AddressORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -2143,7 +2195,7 @@ func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Langu
 		}
 	}
 	ormResponse := LanguageORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&LanguageORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(LanguageORMWithAfterReadFind); ok {
@@ -2181,7 +2233,7 @@ func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&LanguageORM{}).Error
+	err = db.Where(&LanguageORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).Delete(&LanguageORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -2243,7 +2295,7 @@ type LanguageORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateLanguage clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLanguage")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateLanguage")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -2255,7 +2307,7 @@ func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB)
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	lockedRow := &LanguageORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow)
 	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -2303,7 +2355,7 @@ func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_m
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2418,7 +2470,7 @@ func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error)
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&LanguageORM{AccountID: ormObj.AccountID})
 	db = db.Order("id")
 	ormResponse := []LanguageORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -2509,7 +2561,7 @@ func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*C
 		}
 	}
 	ormResponse := CreditCardORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = db.Where(&CreditCardORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormResponse).(CreditCardORMWithAfterReadFind); ok {
@@ -2547,7 +2599,7 @@ func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) e
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&CreditCardORM{}).Error
+	err = db.Where(&CreditCardORM{Id: ormObj.Id, AccountID: ormObj.AccountID}).Delete(&CreditCardORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -2609,7 +2661,7 @@ type CreditCardORMWithAfterDeleteSet interface {
 // DefaultStrictUpdateCreditCard clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCreditCard")
+		return nil, fmt.Errorf("nil argument to DefaultStrictUpdateCreditCard")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -2621,7 +2673,8 @@ func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	lockedRow := &CreditCardORM{}
-	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	db.Model(&ormObj).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id=?", ormObj.Id).First(lockedRow)
+	ormObj.CreatedAt = lockedRow.CreatedAt
 	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
@@ -2669,7 +2722,7 @@ func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *fie
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadCreditCard(ctx, &CreditCard{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadCreditCard(ctx, &CreditCard{Id: in.Id}, db)
 	if err != nil {
 		return nil, err
 	}
@@ -2739,16 +2792,56 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	for _, f := range updateMask.Paths {
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
 		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
 			patchee.CreatedAt = patcher.CreatedAt
 			continue
 		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
 		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
 			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
@@ -2788,7 +2881,7 @@ func DefaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, err
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&CreditCardORM{AccountID: ormObj.AccountID})
 	db = db.Order("id")
 	ormResponse := []CreditCardORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -2902,7 +2995,7 @@ func DefaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&TaskORM{AccountID: ormObj.AccountID})
 	ormResponse := []TaskORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -2,7 +2,7 @@ module github.com/kirinse/protoc-gen-gorm
 
 require (
 	github.com/golang/protobuf v1.5.2
-	github.com/jinzhu/gorm v1.9.16
+	github.com/google/uuid v1.2.0
 	github.com/jinzhu/inflection v1.0.0
 	github.com/kirinse/atlas-app-toolkit v0.24.4
 	github.com/lib/pq v1.9.0
@@ -13,6 +13,7 @@ require (
 	google.golang.org/grpc v1.37.0
 	google.golang.org/protobuf v1.26.0
 	gorm.io/datatypes v1.0.1
+	gorm.io/driver/sqlite v1.1.4
 	gorm.io/gorm v1.21.9
 )
 
@@ -14,6 +14,8 @@ func main() {
 	stringEnums := flags.Bool("enums", false, "Use string representation of protobuf enums instead of integer value if true.")
 	gateway := flags.Bool("gateway", false, "Generates gateway if true.")
 	defaultHandlers := flags.Bool("defaultHandlers", false, "Generates defaultHandlers if true.")
+	uuidLibrary := flags.String("uuid", "", "UUID implementation of the generated code: google, gofrs or satori (default).")
+	dialect := flags.String("dialect", "", "Database of the generated code: postgres (default), mysql or sqlite.")
 	// protogen options, passing flagset callback.
 	opts := &protogen.Options{
 		ParamFunc: flags.Set,
@@ -26,6 +28,8 @@ func main() {
 			StringEnums:      *stringEnums,
 			Gateway:          *gateway,
 			DefaultHandlers:  *defaultHandlers,
+			UUIDLibrary:      *uuidLibrary,
+			Dialect:          *dialect,
 		}
 		plugin.Init(p)
 		plugin.Generate()
@@ -21,10 +21,164 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EnumStorage chooses the column an enum value is stored in
+type EnumStorage int32
+
+const (
+	// integer, or string when the enums plugin parameter is set
+	EnumStorage_ENUM_STORAGE_DEFAULT EnumStorage = 0
+	// the number of the enum value in an integer column
+	EnumStorage_ENUM_STORAGE_INT EnumStorage = 1
+	// the name of the enum value in a text column
+	EnumStorage_ENUM_STORAGE_STRING EnumStorage = 2
+	// the name of the enum value in a column of a native database enum type
+	EnumStorage_ENUM_STORAGE_NATIVE EnumStorage = 3
+)
+
+// Enum value maps for EnumStorage.
+var (
+	EnumStorage_name = map[int32]string{
+		0: "ENUM_STORAGE_DEFAULT",
+		1: "ENUM_STORAGE_INT",
+		2: "ENUM_STORAGE_STRING",
+		3: "ENUM_STORAGE_NATIVE",
+	}
+	EnumStorage_value = map[string]int32{
+		"ENUM_STORAGE_DEFAULT": 0,
+		"ENUM_STORAGE_INT":     1,
+		"ENUM_STORAGE_STRING":  2,
+		"ENUM_STORAGE_NATIVE":  3,
+	}
+)
+
+func (x EnumStorage) Enum() *EnumStorage {
+	p := new(EnumStorage)
+	*p = x
+	return p
+}
+
+func (x EnumStorage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumStorage) Descriptor() protoreflect.EnumDescriptor {
+	return file_gorm_proto_enumTypes[0].Descriptor()
+}
+
+func (EnumStorage) Type() protoreflect.EnumType {
+	return &file_gorm_proto_enumTypes[0]
+}
+
+func (x EnumStorage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EnumStorage) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EnumStorage(num)
+	return nil
+}
+
+// Deprecated: Use EnumStorage.Descriptor instead.
+func (EnumStorage) EnumDescriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{0}
+}
+
+// FieldPermission restricts what the API can do with a column
+type FieldPermission int32
+
+const (
+	// read and written by the API
+	FieldPermission_FIELD_PERMISSION_READ_WRITE FieldPermission = 0
+	// computed by the server, GORM reads the column but never writes it
+	FieldPermission_FIELD_PERMISSION_READ_ONLY FieldPermission = 1
+	// immutable once created, GORM writes the column on creation only
+	FieldPermission_FIELD_PERMISSION_CREATE_ONLY FieldPermission = 2
+	// never returned to the API, e.g. a password hash, updates not setting it
+	// keep the stored value
+	FieldPermission_FIELD_PERMISSION_WRITE_ONLY FieldPermission = 3
+)
+
+// Enum value maps for FieldPermission.
+var (
+	FieldPermission_name = map[int32]string{
+		0: "FIELD_PERMISSION_READ_WRITE",
+		1: "FIELD_PERMISSION_READ_ONLY",
+		2: "FIELD_PERMISSION_CREATE_ONLY",
+		3: "FIELD_PERMISSION_WRITE_ONLY",
+	}
+	FieldPermission_value = map[string]int32{
+		"FIELD_PERMISSION_READ_WRITE":  0,
+		"FIELD_PERMISSION_READ_ONLY":   1,
+		"FIELD_PERMISSION_CREATE_ONLY": 2,
+		"FIELD_PERMISSION_WRITE_ONLY":  3,
+	}
+)
+
+func (x FieldPermission) Enum() *FieldPermission {
+	p := new(FieldPermission)
+	*p = x
+	return p
+}
+
+func (x FieldPermission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldPermission) Descriptor() protoreflect.EnumDescriptor {
+	return file_gorm_proto_enumTypes[1].Descriptor()
+}
+
+func (FieldPermission) Type() protoreflect.EnumType {
+	return &file_gorm_proto_enumTypes[1]
+}
+
+func (x FieldPermission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *FieldPermission) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = FieldPermission(num)
+	return nil
+}
+
+// Deprecated: Use FieldPermission.Descriptor instead.
+func (FieldPermission) EnumDescriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{1}
+}
+
 type GormFileOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// enum_storage is how the enum fields of the file are stored, unless the
+	// field sets its own
+	EnumStorage *EnumStorage `protobuf:"varint,1,opt,name=enum_storage,json=enumStorage,enum=gorm.EnumStorage" json:"enum_storage,omitempty"`
+	// uuid_library is the UUID implementation of the generated code: google
+	// (github.com/google/uuid), gofrs (github.com/gofrs/uuid) or satori
+	// (github.com/satori/go.uuid), it overrides the uuid plugin parameter
+	UuidLibrary *string `protobuf:"bytes,2,opt,name=uuid_library,json=uuidLibrary" json:"uuid_library,omitempty"`
+	// type_mapping stores the fields of a message type of the file as a custom
+	// Go type, converted by user functions
+	TypeMapping []*TypeMapping `protobuf:"bytes,3,rep,name=type_mapping,json=typeMapping" json:"type_mapping,omitempty"`
+	// schema qualifies the tables of the file, e.g. billing for billing.users,
+	// unless the table option of the message is qualified itself
+	Schema *string `protobuf:"bytes,4,opt,name=schema" json:"schema,omitempty"`
+	// table_prefix prefixes the tables of the file and their derived join tables
+	TablePrefix *string `protobuf:"bytes,5,opt,name=table_prefix,json=tablePrefix" json:"table_prefix,omitempty"`
+	// singular_table names the tables of the file and their derived join tables
+	// after the singular type names
+	SingularTable *bool `protobuf:"varint,6,opt,name=singular_table,json=singularTable" json:"singular_table,omitempty"`
 }
 
 func (x *GormFileOptions) Reset() {
@@ -59,6 +213,144 @@ func (*GormFileOptions) Descriptor() ([]byte, []int) {
 	return file_gorm_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GormFileOptions) GetEnumStorage() EnumStorage {
+	if x != nil && x.EnumStorage != nil {
+		return *x.EnumStorage
+	}
+	return EnumStorage_ENUM_STORAGE_DEFAULT
+}
+
+func (x *GormFileOptions) GetUuidLibrary() string {
+	if x != nil && x.UuidLibrary != nil {
+		return *x.UuidLibrary
+	}
+	return ""
+}
+
+func (x *GormFileOptions) GetTypeMapping() []*TypeMapping {
+	if x != nil {
+		return x.TypeMapping
+	}
+	return nil
+}
+
+func (x *GormFileOptions) GetSchema() string {
+	if x != nil && x.Schema != nil {
+		return *x.Schema
+	}
+	return ""
+}
+
+func (x *GormFileOptions) GetTablePrefix() string {
+	if x != nil && x.TablePrefix != nil {
+		return *x.TablePrefix
+	}
+	return ""
+}
+
+func (x *GormFileOptions) GetSingularTable() bool {
+	if x != nil && x.SingularTable != nil {
+		return *x.SingularTable
+	}
+	return false
+}
+
+// TypeMapping maps a message type to a Go type at the ORM level, Go types and
+// functions are either names in package or qualified like
+// github.com/acme/geo.Point, the Go type can be a pointer like *geo.Point
+type TypeMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message is the full name of the message type, e.g. google.type.LatLng
+	Message *string `protobuf:"bytes,1,req,name=message" json:"message,omitempty"`
+	// go_type is the type of the ORM field
+	GoType *string `protobuf:"bytes,2,req,name=go_type,json=goType" json:"go_type,omitempty"`
+	// package is the import path of unqualified Go types and functions
+	Package *string `protobuf:"bytes,3,opt,name=package" json:"package,omitempty"`
+	// column_type is the column type, unless the field tag sets a type
+	ColumnType *string `protobuf:"bytes,4,opt,name=column_type,json=columnType" json:"column_type,omitempty"`
+	// to_orm converts the message, func(*Message) (GoType, error)
+	ToOrm *string `protobuf:"bytes,5,req,name=to_orm,json=toOrm" json:"to_orm,omitempty"`
+	// to_pb converts back to the message, func(GoType) (*Message, error)
+	ToPb *string `protobuf:"bytes,6,req,name=to_pb,json=toPb" json:"to_pb,omitempty"`
+}
+
+func (x *TypeMapping) Reset() {
+	*x = TypeMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gorm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeMapping) ProtoMessage() {}
+
+func (x *TypeMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeMapping.ProtoReflect.Descriptor instead.
+func (*TypeMapping) Descriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TypeMapping) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetGoType() string {
+	if x != nil && x.GoType != nil {
+		return *x.GoType
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetPackage() string {
+	if x != nil && x.Package != nil {
+		return *x.Package
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetColumnType() string {
+	if x != nil && x.ColumnType != nil {
+		return *x.ColumnType
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetToOrm() string {
+	if x != nil && x.ToOrm != nil {
+		return *x.ToOrm
+	}
+	return ""
+}
+
+func (x *TypeMapping) GetToPb() string {
+	if x != nil && x.ToPb != nil {
+		return *x.ToPb
+	}
+	return ""
+}
+
 type GormMessageOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -68,12 +360,27 @@ type GormMessageOptions struct {
 	Include      []*ExtraField `protobuf:"bytes,2,rep,name=include" json:"include,omitempty"`
 	Table        *string       `protobuf:"bytes,3,opt,name=table" json:"table,omitempty"`
 	MultiAccount *bool         `protobuf:"varint,4,opt,name=multi_account,json=multiAccount" json:"multi_account,omitempty"`
+	// indexes are the indexes over one or more columns of the table
+	Indexes []*GormIndex `protobuf:"bytes,5,rep,name=indexes" json:"indexes,omitempty"`
+	// checks are the check constraints of the table
+	Checks []*GormCheck `protobuf:"bytes,6,rep,name=checks" json:"checks,omitempty"`
+	// soft_delete stores deletions in a deleted_at column, a Timestamp field
+	// named deleted_at or one added to the ORM type, and generates the restore
+	// and hard delete handlers
+	SoftDelete *bool `protobuf:"varint,7,opt,name=soft_delete,json=softDelete" json:"soft_delete,omitempty"`
+	// version_field is the integer field guarding the updates against concurrent
+	// writers, an update must carry the version it read, the version is bumped
+	// on each update and a stale one fails with a VersionConflictError
+	VersionField *string `protobuf:"bytes,8,opt,name=version_field,json=versionField" json:"version_field,omitempty"`
+	// timestamps fills the created_at and updated_at fields of the message with
+	// the times of the creation and of each save
+	Timestamps *GormTimestamps `protobuf:"bytes,9,opt,name=timestamps" json:"timestamps,omitempty"`
 }
 
 func (x *GormMessageOptions) Reset() {
 	*x = GormMessageOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[1]
+		mi := &file_gorm_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -86,7 +393,7 @@ func (x *GormMessageOptions) String() string {
 func (*GormMessageOptions) ProtoMessage() {}
 
 func (x *GormMessageOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[1]
+	mi := &file_gorm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -99,7 +406,7 @@ func (x *GormMessageOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormMessageOptions.ProtoReflect.Descriptor instead.
 func (*GormMessageOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{1}
+	return file_gorm_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GormMessageOptions) GetOrmable() bool {
@@ -130,6 +437,354 @@ func (x *GormMessageOptions) GetMultiAccount() bool {
 	return false
 }
 
+func (x *GormMessageOptions) GetIndexes() []*GormIndex {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *GormMessageOptions) GetChecks() []*GormCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GormMessageOptions) GetSoftDelete() bool {
+	if x != nil && x.SoftDelete != nil {
+		return *x.SoftDelete
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetVersionField() string {
+	if x != nil && x.VersionField != nil {
+		return *x.VersionField
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetTimestamps() *GormTimestamps {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+// GormTimestamps are the fields GORM fills with the times of the creation and
+// of each save, Timestamps or integer unix times
+type GormTimestamps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// created_at and updated_at name the fields, an empty name skips the field
+	CreatedAt *string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,def=created_at" json:"created_at,omitempty"`
+	UpdatedAt *string `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,def=updated_at" json:"updated_at,omitempty"`
+	// precision of the times, sec (default), milli or micro for Timestamps and
+	// sec, milli or nano for integer unix times
+	Precision *string `protobuf:"bytes,3,opt,name=precision" json:"precision,omitempty"`
+}
+
+// Default values for GormTimestamps fields.
+const (
+	Default_GormTimestamps_CreatedAt = string("created_at")
+	Default_GormTimestamps_UpdatedAt = string("updated_at")
+)
+
+func (x *GormTimestamps) Reset() {
+	*x = GormTimestamps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gorm_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GormTimestamps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GormTimestamps) ProtoMessage() {}
+
+func (x *GormTimestamps) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GormTimestamps.ProtoReflect.Descriptor instead.
+func (*GormTimestamps) Descriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GormTimestamps) GetCreatedAt() string {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return Default_GormTimestamps_CreatedAt
+}
+
+func (x *GormTimestamps) GetUpdatedAt() string {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return Default_GormTimestamps_UpdatedAt
+}
+
+func (x *GormTimestamps) GetPrecision() string {
+	if x != nil && x.Precision != nil {
+		return *x.Precision
+	}
+	return ""
+}
+
+// GormCheck is a check constraint of the table, GORM reads it from the tag
+// of a column so each check is rendered on the first column it references
+// that has no check
+type GormCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the constraint, of letters, - and _ only
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// expression is the condition rows must meet, e.g. "start_at < end_at"
+	Expression *string `protobuf:"bytes,2,req,name=expression" json:"expression,omitempty"`
+}
+
+func (x *GormCheck) Reset() {
+	*x = GormCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gorm_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GormCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GormCheck) ProtoMessage() {}
+
+func (x *GormCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GormCheck.ProtoReflect.Descriptor instead.
+func (*GormCheck) Descriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GormCheck) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GormCheck) GetExpression() string {
+	if x != nil && x.Expression != nil {
+		return *x.Expression
+	}
+	return ""
+}
+
+// GormIndex is an index of the table, rendered as GORM index tags of the
+// fields it references
+type GormIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the index, idx_<table>_<columns> by default
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// columns are the columns of the index, in order
+	Columns []*GormIndexColumn `protobuf:"bytes,2,rep,name=columns" json:"columns,omitempty"`
+	Unique  *bool              `protobuf:"varint,3,opt,name=unique" json:"unique,omitempty"`
+	// type is the index method, btree, hash, gist, spgist, gin or brin on
+	// postgres and btree or hash on mysql
+	Type *string `protobuf:"bytes,4,opt,name=type" json:"type,omitempty"`
+	// where is the predicate of a partial index, e.g. "deleted_at IS NULL",
+	// mysql has no partial indexes
+	Where   *string `protobuf:"bytes,5,opt,name=where" json:"where,omitempty"`
+	Comment *string `protobuf:"bytes,6,opt,name=comment" json:"comment,omitempty"`
+}
+
+func (x *GormIndex) Reset() {
+	*x = GormIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gorm_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GormIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GormIndex) ProtoMessage() {}
+
+func (x *GormIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GormIndex.ProtoReflect.Descriptor instead.
+func (*GormIndex) Descriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GormIndex) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *GormIndex) GetColumns() []*GormIndexColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *GormIndex) GetUnique() bool {
+	if x != nil && x.Unique != nil {
+		return *x.Unique
+	}
+	return false
+}
+
+func (x *GormIndex) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *GormIndex) GetWhere() string {
+	if x != nil && x.Where != nil {
+		return *x.Where
+	}
+	return ""
+}
+
+func (x *GormIndex) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type GormIndexColumn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// field is the name of the field, as in the proto message or as in the
+	// include option, or the Go name of a generated foreign key
+	Field *string `protobuf:"bytes,1,req,name=field" json:"field,omitempty"`
+	// sort is the order of the column, asc or desc
+	Sort *string `protobuf:"bytes,2,opt,name=sort" json:"sort,omitempty"`
+	// expression is indexed instead of the column, e.g. lower(email)
+	Expression *string `protobuf:"bytes,3,opt,name=expression" json:"expression,omitempty"`
+	Collate    *string `protobuf:"bytes,4,opt,name=collate" json:"collate,omitempty"`
+	// length is the length of the indexed prefix of the column on mysql
+	Length *int32 `protobuf:"varint,5,opt,name=length" json:"length,omitempty"`
+}
+
+func (x *GormIndexColumn) Reset() {
+	*x = GormIndexColumn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gorm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GormIndexColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GormIndexColumn) ProtoMessage() {}
+
+func (x *GormIndexColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GormIndexColumn.ProtoReflect.Descriptor instead.
+func (*GormIndexColumn) Descriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GormIndexColumn) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+func (x *GormIndexColumn) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *GormIndexColumn) GetExpression() string {
+	if x != nil && x.Expression != nil {
+		return *x.Expression
+	}
+	return ""
+}
+
+func (x *GormIndexColumn) GetCollate() string {
+	if x != nil && x.Collate != nil {
+		return *x.Collate
+	}
+	return ""
+}
+
+func (x *GormIndexColumn) GetLength() int32 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
 type ExtraField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -144,7 +799,7 @@ type ExtraField struct {
 func (x *ExtraField) Reset() {
 	*x = ExtraField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[2]
+		mi := &file_gorm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -157,7 +812,7 @@ func (x *ExtraField) String() string {
 func (*ExtraField) ProtoMessage() {}
 
 func (x *ExtraField) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[2]
+	mi := &file_gorm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,7 +825,7 @@ func (x *ExtraField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraField.ProtoReflect.Descriptor instead.
 func (*ExtraField) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{2}
+	return file_gorm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ExtraField) GetType() string {
@@ -215,12 +870,38 @@ type GormFieldOptions struct {
 	//	*GormFieldOptions_ManyToMany
 	Association isGormFieldOptions_Association `protobuf_oneof:"association"`
 	ReferenceOf *string                        `protobuf:"bytes,7,opt,name=reference_of,json=referenceOf" json:"reference_of,omitempty"`
-}
+	// json stores a repeated message of a non-ormable type as a JSON array
+	// encoded with protojson, set it to false to skip the field instead. The
+	// keys are the json_name of the element fields, derived from their names: a
+	// renamed element field must keep its former json_name, e.g.
+	// [json_name = "hostName"], or ToPB fails on the unknown keys stored before
+	Json *bool `protobuf:"varint,8,opt,name=json,def=1" json:"json,omitempty"`
+	// enum_storage overrides the file enum_storage for an enum field
+	EnumStorage *EnumStorage `protobuf:"varint,9,opt,name=enum_storage,json=enumStorage,enum=gorm.EnumStorage" json:"enum_storage,omitempty"`
+	// to_orm_func replaces the conversion of the field to its ORM type by a
+	// call to the Go function, func(PBType) (ORMType, error), qualified like
+	// github.com/acme/mail.Normalize or in the package of the generated code
+	ToOrmFunc *string `protobuf:"bytes,10,opt,name=to_orm_func,json=toOrmFunc" json:"to_orm_func,omitempty"`
+	// to_pb_func replaces the conversion of the field back to its PB type by a
+	// call to the Go function, func(ORMType) (PBType, error)
+	ToPbFunc *string `protobuf:"bytes,11,opt,name=to_pb_func,json=toPbFunc" json:"to_pb_func,omitempty"`
+	// permission restricts what the API can do with the column, immutable
+	// fields are rejected in update masks and write-only ones are not copied by
+	// ToPB
+	Permission *FieldPermission `protobuf:"varint,12,opt,name=permission,enum=gorm.FieldPermission" json:"permission,omitempty"`
+	// skip_migration keeps GORM migrations from adding or altering the column
+	SkipMigration *bool `protobuf:"varint,13,opt,name=skip_migration,json=skipMigration" json:"skip_migration,omitempty"`
+}
+
+// Default values for GormFieldOptions fields.
+const (
+	Default_GormFieldOptions_Json = bool(true)
+)
 
 func (x *GormFieldOptions) Reset() {
 	*x = GormFieldOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[3]
+		mi := &file_gorm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +914,7 @@ func (x *GormFieldOptions) String() string {
 func (*GormFieldOptions) ProtoMessage() {}
 
 func (x *GormFieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[3]
+	mi := &file_gorm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +927,7 @@ func (x *GormFieldOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormFieldOptions.ProtoReflect.Descriptor instead.
 func (*GormFieldOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{3}
+	return file_gorm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GormFieldOptions) GetTag() *GormTag {
@@ -274,35 +955,77 @@ func (x *GormFieldOptions) GetHasOne() *HasOneOptions {
 	if x, ok := x.GetAssociation().(*GormFieldOptions_HasOne); ok {
 		return x.HasOne
 	}
-	return nil
+	return nil
+}
+
+func (x *GormFieldOptions) GetBelongsTo() *BelongsToOptions {
+	if x, ok := x.GetAssociation().(*GormFieldOptions_BelongsTo); ok {
+		return x.BelongsTo
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetHasMany() *HasManyOptions {
+	if x, ok := x.GetAssociation().(*GormFieldOptions_HasMany); ok {
+		return x.HasMany
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetManyToMany() *ManyToManyOptions {
+	if x, ok := x.GetAssociation().(*GormFieldOptions_ManyToMany); ok {
+		return x.ManyToMany
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetReferenceOf() string {
+	if x != nil && x.ReferenceOf != nil {
+		return *x.ReferenceOf
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetJson() bool {
+	if x != nil && x.Json != nil {
+		return *x.Json
+	}
+	return Default_GormFieldOptions_Json
+}
+
+func (x *GormFieldOptions) GetEnumStorage() EnumStorage {
+	if x != nil && x.EnumStorage != nil {
+		return *x.EnumStorage
+	}
+	return EnumStorage_ENUM_STORAGE_DEFAULT
 }
 
-func (x *GormFieldOptions) GetBelongsTo() *BelongsToOptions {
-	if x, ok := x.GetAssociation().(*GormFieldOptions_BelongsTo); ok {
-		return x.BelongsTo
+func (x *GormFieldOptions) GetToOrmFunc() string {
+	if x != nil && x.ToOrmFunc != nil {
+		return *x.ToOrmFunc
 	}
-	return nil
+	return ""
 }
 
-func (x *GormFieldOptions) GetHasMany() *HasManyOptions {
-	if x, ok := x.GetAssociation().(*GormFieldOptions_HasMany); ok {
-		return x.HasMany
+func (x *GormFieldOptions) GetToPbFunc() string {
+	if x != nil && x.ToPbFunc != nil {
+		return *x.ToPbFunc
 	}
-	return nil
+	return ""
 }
 
-func (x *GormFieldOptions) GetManyToMany() *ManyToManyOptions {
-	if x, ok := x.GetAssociation().(*GormFieldOptions_ManyToMany); ok {
-		return x.ManyToMany
+func (x *GormFieldOptions) GetPermission() FieldPermission {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
 	}
-	return nil
+	return FieldPermission_FIELD_PERMISSION_READ_WRITE
 }
 
-func (x *GormFieldOptions) GetReferenceOf() string {
-	if x != nil && x.ReferenceOf != nil {
-		return *x.ReferenceOf
+func (x *GormFieldOptions) GetSkipMigration() bool {
+	if x != nil && x.SkipMigration != nil {
+		return *x.SkipMigration
 	}
-	return ""
+	return false
 }
 
 type isGormFieldOptions_Association interface {
@@ -357,17 +1080,36 @@ type GormTag struct {
 	ManyToMany                     *string `protobuf:"bytes,17,opt,name=many_to_many,json=manyToMany" json:"many_to_many,omitempty"`
 	JointableForeignkey            *string `protobuf:"bytes,18,opt,name=jointable_foreignkey,json=jointableForeignkey" json:"jointable_foreignkey,omitempty"`
 	AssociationJointableForeignkey *string `protobuf:"bytes,19,opt,name=association_jointable_foreignkey,json=associationJointableForeignkey" json:"association_jointable_foreignkey,omitempty"`
-	AssociationAutoupdate          *bool   `protobuf:"varint,20,opt,name=association_autoupdate,json=associationAutoupdate" json:"association_autoupdate,omitempty"`
-	AssociationAutocreate          *bool   `protobuf:"varint,21,opt,name=association_autocreate,json=associationAutocreate" json:"association_autocreate,omitempty"`
-	AssociationSaveReference       *bool   `protobuf:"varint,22,opt,name=association_save_reference,json=associationSaveReference" json:"association_save_reference,omitempty"`
-	Preload                        *bool   `protobuf:"varint,23,opt,name=preload" json:"preload,omitempty"`
-	Comment                        *string `protobuf:"bytes,24,opt,name=comment" json:"comment,omitempty"`
+	// association_autoupdate and association_autocreate set to false omit the
+	// association when the default handlers update or create the parent,
+	// association_save_reference has no GORM v2 equivalent and is ignored
+	AssociationAutoupdate    *bool   `protobuf:"varint,20,opt,name=association_autoupdate,json=associationAutoupdate" json:"association_autoupdate,omitempty"`
+	AssociationAutocreate    *bool   `protobuf:"varint,21,opt,name=association_autocreate,json=associationAutocreate" json:"association_autocreate,omitempty"`
+	AssociationSaveReference *bool   `protobuf:"varint,22,opt,name=association_save_reference,json=associationSaveReference" json:"association_save_reference,omitempty"`
+	Preload                  *bool   `protobuf:"varint,23,opt,name=preload" json:"preload,omitempty"`
+	Comment                  *string `protobuf:"bytes,24,opt,name=comment" json:"comment,omitempty"`
+	// constraint is the foreign key constraint of an association, e.g.
+	// "OnUpdate:CASCADE,OnDelete:SET NULL", or "-" to create none
+	Constraint *string `protobuf:"bytes,25,opt,name=constraint" json:"constraint,omitempty"`
+	// check is a check constraint of the column, e.g. "age >= 0", optionally
+	// named by a prefix of letters, - and _ like "chk_age,age >= 0"
+	Check *string `protobuf:"bytes,26,opt,name=check" json:"check,omitempty"`
+	// auto_create_time fills the column with the time of the creation and
+	// auto_update_time with the time of each save, the value is the precision,
+	// sec (default), milli or micro for Timestamps, whose columns store micro
+	// seconds at most on Postgres and MySQL, and sec, milli or nano for integer
+	// columns storing unix times
+	AutoCreateTime *string `protobuf:"bytes,27,opt,name=auto_create_time,json=autoCreateTime" json:"auto_create_time,omitempty"`
+	AutoUpdateTime *string `protobuf:"bytes,28,opt,name=auto_update_time,json=autoUpdateTime" json:"auto_update_time,omitempty"`
+	// scale is the number of fractional digits of a decimal column, precision
+	// being its total number of digits
+	Scale *int32 `protobuf:"varint,29,opt,name=scale" json:"scale,omitempty"`
 }
 
 func (x *GormTag) Reset() {
 	*x = GormTag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[4]
+		mi := &file_gorm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -380,7 +1122,7 @@ func (x *GormTag) String() string {
 func (*GormTag) ProtoMessage() {}
 
 func (x *GormTag) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[4]
+	mi := &file_gorm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +1135,7 @@ func (x *GormTag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormTag.ProtoReflect.Descriptor instead.
 func (*GormTag) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{4}
+	return file_gorm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GormTag) GetColumn() string {
@@ -564,6 +1306,41 @@ func (x *GormTag) GetComment() string {
 	return ""
 }
 
+func (x *GormTag) GetConstraint() string {
+	if x != nil && x.Constraint != nil {
+		return *x.Constraint
+	}
+	return ""
+}
+
+func (x *GormTag) GetCheck() string {
+	if x != nil && x.Check != nil {
+		return *x.Check
+	}
+	return ""
+}
+
+func (x *GormTag) GetAutoCreateTime() string {
+	if x != nil && x.AutoCreateTime != nil {
+		return *x.AutoCreateTime
+	}
+	return ""
+}
+
+func (x *GormTag) GetAutoUpdateTime() string {
+	if x != nil && x.AutoUpdateTime != nil {
+		return *x.AutoUpdateTime
+	}
+	return ""
+}
+
+func (x *GormTag) GetScale() int32 {
+	if x != nil && x.Scale != nil {
+		return *x.Scale
+	}
+	return 0
+}
+
 type HasOneOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -576,15 +1353,22 @@ type HasOneOptions struct {
 	AssociationAutocreate    *bool    `protobuf:"varint,5,opt,name=association_autocreate,json=associationAutocreate" json:"association_autocreate,omitempty"`
 	AssociationSaveReference *bool    `protobuf:"varint,6,opt,name=association_save_reference,json=associationSaveReference" json:"association_save_reference,omitempty"`
 	Preload                  *bool    `protobuf:"varint,7,opt,name=preload" json:"preload,omitempty"`
-	Replace                  *bool    `protobuf:"varint,8,opt,name=replace" json:"replace,omitempty"`
-	Append                   *bool    `protobuf:"varint,9,opt,name=append" json:"append,omitempty"`
-	Clear                    *bool    `protobuf:"varint,10,opt,name=clear" json:"clear,omitempty"`
+	// replace, append and clear pick the GORM association mode the default
+	// strict update handler saves the association with, they are not tags
+	Replace    *bool   `protobuf:"varint,8,opt,name=replace" json:"replace,omitempty"`
+	Append     *bool   `protobuf:"varint,9,opt,name=append" json:"append,omitempty"`
+	Clear      *bool   `protobuf:"varint,10,opt,name=clear" json:"clear,omitempty"`
+	Constraint *string `protobuf:"bytes,11,opt,name=constraint" json:"constraint,omitempty"`
+	// on_delete and on_update are the actions of the foreign key constraint,
+	// CASCADE, SET NULL, SET DEFAULT, RESTRICT or NO ACTION
+	OnDelete *string `protobuf:"bytes,12,opt,name=on_delete,json=onDelete" json:"on_delete,omitempty"`
+	OnUpdate *string `protobuf:"bytes,13,opt,name=on_update,json=onUpdate" json:"on_update,omitempty"`
 }
 
 func (x *HasOneOptions) Reset() {
 	*x = HasOneOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[5]
+		mi := &file_gorm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +1381,7 @@ func (x *HasOneOptions) String() string {
 func (*HasOneOptions) ProtoMessage() {}
 
 func (x *HasOneOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[5]
+	mi := &file_gorm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +1394,7 @@ func (x *HasOneOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HasOneOptions.ProtoReflect.Descriptor instead.
 func (*HasOneOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{5}
+	return file_gorm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *HasOneOptions) GetForeignkey() string {
@@ -683,6 +1467,27 @@ func (x *HasOneOptions) GetClear() bool {
 	return false
 }
 
+func (x *HasOneOptions) GetConstraint() string {
+	if x != nil && x.Constraint != nil {
+		return *x.Constraint
+	}
+	return ""
+}
+
+func (x *HasOneOptions) GetOnDelete() string {
+	if x != nil && x.OnDelete != nil {
+		return *x.OnDelete
+	}
+	return ""
+}
+
+func (x *HasOneOptions) GetOnUpdate() string {
+	if x != nil && x.OnUpdate != nil {
+		return *x.OnUpdate
+	}
+	return ""
+}
+
 type BelongsToOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -695,12 +1500,15 @@ type BelongsToOptions struct {
 	AssociationAutocreate    *bool    `protobuf:"varint,5,opt,name=association_autocreate,json=associationAutocreate" json:"association_autocreate,omitempty"`
 	AssociationSaveReference *bool    `protobuf:"varint,6,opt,name=association_save_reference,json=associationSaveReference" json:"association_save_reference,omitempty"`
 	Preload                  *bool    `protobuf:"varint,7,opt,name=preload" json:"preload,omitempty"`
+	Constraint               *string  `protobuf:"bytes,8,opt,name=constraint" json:"constraint,omitempty"`
+	OnDelete                 *string  `protobuf:"bytes,9,opt,name=on_delete,json=onDelete" json:"on_delete,omitempty"`
+	OnUpdate                 *string  `protobuf:"bytes,10,opt,name=on_update,json=onUpdate" json:"on_update,omitempty"`
 }
 
 func (x *BelongsToOptions) Reset() {
 	*x = BelongsToOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[6]
+		mi := &file_gorm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -713,7 +1521,7 @@ func (x *BelongsToOptions) String() string {
 func (*BelongsToOptions) ProtoMessage() {}
 
 func (x *BelongsToOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[6]
+	mi := &file_gorm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +1534,7 @@ func (x *BelongsToOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BelongsToOptions.ProtoReflect.Descriptor instead.
 func (*BelongsToOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{6}
+	return file_gorm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BelongsToOptions) GetForeignkey() string {
@@ -778,6 +1586,27 @@ func (x *BelongsToOptions) GetPreload() bool {
 	return false
 }
 
+func (x *BelongsToOptions) GetConstraint() string {
+	if x != nil && x.Constraint != nil {
+		return *x.Constraint
+	}
+	return ""
+}
+
+func (x *BelongsToOptions) GetOnDelete() string {
+	if x != nil && x.OnDelete != nil {
+		return *x.OnDelete
+	}
+	return ""
+}
+
+func (x *BelongsToOptions) GetOnUpdate() string {
+	if x != nil && x.OnUpdate != nil {
+		return *x.OnUpdate
+	}
+	return ""
+}
+
 type HasManyOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -795,12 +1624,15 @@ type HasManyOptions struct {
 	Replace                  *bool    `protobuf:"varint,10,opt,name=replace" json:"replace,omitempty"`
 	Append                   *bool    `protobuf:"varint,11,opt,name=append" json:"append,omitempty"`
 	Clear                    *bool    `protobuf:"varint,12,opt,name=clear" json:"clear,omitempty"`
+	Constraint               *string  `protobuf:"bytes,13,opt,name=constraint" json:"constraint,omitempty"`
+	OnDelete                 *string  `protobuf:"bytes,14,opt,name=on_delete,json=onDelete" json:"on_delete,omitempty"`
+	OnUpdate                 *string  `protobuf:"bytes,15,opt,name=on_update,json=onUpdate" json:"on_update,omitempty"`
 }
 
 func (x *HasManyOptions) Reset() {
 	*x = HasManyOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[7]
+		mi := &file_gorm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -813,7 +1645,7 @@ func (x *HasManyOptions) String() string {
 func (*HasManyOptions) ProtoMessage() {}
 
 func (x *HasManyOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[7]
+	mi := &file_gorm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,7 +1658,7 @@ func (x *HasManyOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HasManyOptions.ProtoReflect.Descriptor instead.
 func (*HasManyOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{7}
+	return file_gorm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *HasManyOptions) GetForeignkey() string {
@@ -913,6 +1745,27 @@ func (x *HasManyOptions) GetClear() bool {
 	return false
 }
 
+func (x *HasManyOptions) GetConstraint() string {
+	if x != nil && x.Constraint != nil {
+		return *x.Constraint
+	}
+	return ""
+}
+
+func (x *HasManyOptions) GetOnDelete() string {
+	if x != nil && x.OnDelete != nil {
+		return *x.OnDelete
+	}
+	return ""
+}
+
+func (x *HasManyOptions) GetOnUpdate() string {
+	if x != nil && x.OnUpdate != nil {
+		return *x.OnUpdate
+	}
+	return ""
+}
+
 type ManyToManyOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -930,12 +1783,15 @@ type ManyToManyOptions struct {
 	Replace                        *bool   `protobuf:"varint,10,opt,name=replace" json:"replace,omitempty"`
 	Append                         *bool   `protobuf:"varint,11,opt,name=append" json:"append,omitempty"`
 	Clear                          *bool   `protobuf:"varint,13,opt,name=clear" json:"clear,omitempty"`
+	Constraint                     *string `protobuf:"bytes,14,opt,name=constraint" json:"constraint,omitempty"`
+	OnDelete                       *string `protobuf:"bytes,15,opt,name=on_delete,json=onDelete" json:"on_delete,omitempty"`
+	OnUpdate                       *string `protobuf:"bytes,16,opt,name=on_update,json=onUpdate" json:"on_update,omitempty"`
 }
 
 func (x *ManyToManyOptions) Reset() {
 	*x = ManyToManyOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[8]
+		mi := &file_gorm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -948,7 +1804,7 @@ func (x *ManyToManyOptions) String() string {
 func (*ManyToManyOptions) ProtoMessage() {}
 
 func (x *ManyToManyOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[8]
+	mi := &file_gorm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1817,7 @@ func (x *ManyToManyOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManyToManyOptions.ProtoReflect.Descriptor instead.
 func (*ManyToManyOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{8}
+	return file_gorm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ManyToManyOptions) GetJointable() string {
@@ -1048,6 +1904,85 @@ func (x *ManyToManyOptions) GetClear() bool {
 	return false
 }
 
+func (x *ManyToManyOptions) GetConstraint() string {
+	if x != nil && x.Constraint != nil {
+		return *x.Constraint
+	}
+	return ""
+}
+
+func (x *ManyToManyOptions) GetOnDelete() string {
+	if x != nil && x.OnDelete != nil {
+		return *x.OnDelete
+	}
+	return ""
+}
+
+func (x *ManyToManyOptions) GetOnUpdate() string {
+	if x != nil && x.OnUpdate != nil {
+		return *x.OnUpdate
+	}
+	return ""
+}
+
+type GormOneofOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// json stores the whole oneof in a single JSON column instead of one
+	// nullable column per member plus a discriminator column
+	Json *bool `protobuf:"varint,1,opt,name=json" json:"json,omitempty"`
+	// tag is applied to the discriminator column, or to the JSON column
+	Tag *GormTag `protobuf:"bytes,2,opt,name=tag" json:"tag,omitempty"`
+}
+
+func (x *GormOneofOptions) Reset() {
+	*x = GormOneofOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gorm_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GormOneofOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GormOneofOptions) ProtoMessage() {}
+
+func (x *GormOneofOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_gorm_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GormOneofOptions.ProtoReflect.Descriptor instead.
+func (*GormOneofOptions) Descriptor() ([]byte, []int) {
+	return file_gorm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GormOneofOptions) GetJson() bool {
+	if x != nil && x.Json != nil {
+		return *x.Json
+	}
+	return false
+}
+
+func (x *GormOneofOptions) GetTag() *GormTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
 type AutoServerOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1061,7 +1996,7 @@ type AutoServerOptions struct {
 func (x *AutoServerOptions) Reset() {
 	*x = AutoServerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[9]
+		mi := &file_gorm_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1074,7 +2009,7 @@ func (x *AutoServerOptions) String() string {
 func (*AutoServerOptions) ProtoMessage() {}
 
 func (x *AutoServerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[9]
+	mi := &file_gorm_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1087,7 +2022,7 @@ func (x *AutoServerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoServerOptions.ProtoReflect.Descriptor instead.
 func (*AutoServerOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{9}
+	return file_gorm_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AutoServerOptions) GetAutogen() bool {
@@ -1122,7 +2057,7 @@ type MethodOptions struct {
 func (x *MethodOptions) Reset() {
 	*x = MethodOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gorm_proto_msgTypes[10]
+		mi := &file_gorm_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1135,7 +2070,7 @@ func (x *MethodOptions) String() string {
 func (*MethodOptions) ProtoMessage() {}
 
 func (x *MethodOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_gorm_proto_msgTypes[10]
+	mi := &file_gorm_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,7 +2083,7 @@ func (x *MethodOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MethodOptions.ProtoReflect.Descriptor instead.
 func (*MethodOptions) Descriptor() ([]byte, []int) {
-	return file_gorm_proto_rawDescGZIP(), []int{10}
+	return file_gorm_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MethodOptions) GetObjectType() string {
@@ -1183,6 +2118,14 @@ var file_gorm_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,52119,opt,name=field",
 		Filename:      "gorm.proto",
 	},
+	{
+		ExtendedType:  (*descriptor.OneofOptions)(nil),
+		ExtensionType: (*GormOneofOptions)(nil),
+		Field:         52119,
+		Name:          "gorm.oneof",
+		Tag:           "bytes,52119,opt,name=oneof",
+		Filename:      "gorm.proto",
+	},
 	{
 		ExtendedType:  (*descriptor.ServiceOptions)(nil),
 		ExtensionType: (*AutoServerOptions)(nil),
@@ -1221,16 +2164,22 @@ var (
 	E_Field = &file_gorm_proto_extTypes[2]
 )
 
+// Extension fields to descriptor.OneofOptions.
+var (
+	// optional gorm.GormOneofOptions oneof = 52119;
+	E_Oneof = &file_gorm_proto_extTypes[3]
+)
+
 // Extension fields to descriptor.ServiceOptions.
 var (
 	// optional gorm.AutoServerOptions server = 52119;
-	E_Server = &file_gorm_proto_extTypes[3]
+	E_Server = &file_gorm_proto_extTypes[4]
 )
 
 // Extension fields to descriptor.MethodOptions.
 var (
 	// optional gorm.MethodOptions method = 52119;
-	E_Method = &file_gorm_proto_extTypes[4]
+	E_Method = &file_gorm_proto_extTypes[5]
 )
 
 var File_gorm_proto protoreflect.FileDescriptor
@@ -1239,216 +2188,339 @@ var file_gorm_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x6f,
 	0x72, 0x6d, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x47, 0x6f, 0x72, 0x6d,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x08, 0x52,
-	0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
-	0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x6f, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61,
-	0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x22, 0xd2, 0x02, 0x0a, 0x10, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61,
-	0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x68, 0x61,
-	0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f,
-	0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x48, 0x00, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x62, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x09, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
-	0x73, 0x54, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73,
-	0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x68,
-	0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x3b, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f, 0x74,
-	0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d,
-	0x61, 0x6e, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x0d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe8, 0x06, 0x0a, 0x07, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61,
-	0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x25, 0x0a,
-	0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x62,
-	0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f,
-	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
-	0x6e, 0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
-	0x79, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e,
-	0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d,
-	0x61, 0x6e, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x20, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x1e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69,
-	0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
-	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c,
-	0x0a, 0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61,
-	0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
-	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0xaa, 0x03, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
-	0x65, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72,
-	0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12,
-	0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
-	0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a,
-	0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76,
-	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
-	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0xe5, 0x02,
-	0x0a, 0x10, 0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
-	0x65, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72,
-	0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12,
-	0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
-	0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a,
-	0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76,
-	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
-	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8f, 0x04, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x4d, 0x61, 0x6e,
-	0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f,
-	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52,
-	0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35,
-	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f,
-	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
-	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x12,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74,
-	0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e,
-	0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
-	0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x73, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70,
-	0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x93, 0x04, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x79,
-	0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a,
-	0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35,
-	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f,
-	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
-	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x02, 0x0a, 0x0f, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0c, 0x65, 0x6e, 0x75, 0x6d,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x75, 0x69, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x67,
+	0x75, 0x6c, 0x61, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0b, 0x54, 0x79,
+	0x70, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x6f, 0x5f, 0x6f, 0x72,
+	0x6d, 0x18, 0x05, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x4f, 0x72, 0x6d, 0x12, 0x13,
+	0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x70, 0x62, 0x18, 0x06, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x6f, 0x50, 0x62, 0x22, 0xe5, 0x02, 0x0a, 0x12, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x72, 0x6d,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f,
+	0x72, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x52,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0e,
+	0x47, 0x6f, 0x72, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x29,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x3a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x09, 0x47, 0x6f, 0x72, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x09, 0x47, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f,
+	0x72, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x0f, 0x47, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x22, 0x6f, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72,
+	0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x22, 0xbe, 0x04, 0x0a, 0x10, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72,
+	0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x6f,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x2e, 0x0a,
+	0x07, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x12, 0x37, 0x0a,
+	0x0a, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
+	0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x09, 0x62, 0x65, 0x6c,
+	0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x61,
+	0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e,
+	0x48, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00,
+	0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x3b, 0x0a, 0x0c, 0x6d, 0x61, 0x6e,
+	0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e,
+	0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x79,
+	0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x66, 0x12, 0x18, 0x0a, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x04, 0x6a,
+	0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0c, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x65, 0x6e,
+	0x75, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f,
+	0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x6f, 0x4f, 0x72, 0x6d, 0x46, 0x75, 0x6e, 0x63, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6f, 0x5f,
+	0x70, 0x62, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x6f, 0x50, 0x62, 0x46, 0x75, 0x6e, 0x63, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x6f,
+	0x72, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x08, 0x0a, 0x07, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61,
+	0x6e, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69,
 	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x20, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x1e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e,
 	0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12,
 	0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
-	0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f,
 	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a,
 	0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76,
-	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61,
 	0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
-	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x77, 0x0a,
+	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x84, 0x04, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
+	0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75,
+	0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xbf, 0x03, 0x0a, 0x10, 0x42, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54,
+	0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61,
+	0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
+	0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xe9, 0x04, 0x0a, 0x0e, 0x48, 0x61, 0x73,
+	0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54,
+	0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61,
+	0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x3b, 0x0a, 0x12, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f,
+	0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0xed, 0x04, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d,
+	0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f,
+	0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a,
+	0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a, 0x6f, 0x69, 0x6e,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
+	0x65, 0x79, 0x12, 0x48, 0x0a, 0x20, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x61, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x47, 0x6f, 0x72, 0x6d, 0x4f, 0x6e, 0x65, 0x6f,
+	0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x77, 0x0a,
 	0x11, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
@@ -1459,36 +2531,57 @@ var file_gorm_proto_rawDesc = []byte{
 	0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x22, 0x30, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
 	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
 	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x52, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f,
-	0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x3a, 0x4f, 0x0a, 0x04,
-	0x6f, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x3a, 0x4d, 0x0a,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x52, 0x0a, 0x06,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x3a, 0x4d, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42,
-	0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x69,
-	0x72, 0x69, 0x6e, 0x73, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
-	0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3b, 0x67, 0x6f,
-	0x72, 0x6d,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x6f, 0x0a, 0x0b, 0x45, 0x6e, 0x75, 0x6d,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
+	0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47,
+	0x45, 0x5f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
+	0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45,
+	0x5f, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x2a, 0x95, 0x01, 0x0a, 0x0f, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x1b, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x20,
+	0x0a, 0x1c, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02,
+	0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10,
+	0x03, 0x3a, 0x52, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d,
+	0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x73, 0x3a, 0x4f, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97,
+	0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f,
+	0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x3a, 0x4d, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97,
+	0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f,
+	0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4d, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72,
+	0x6d, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x3a, 0x52, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3a, 0x4d, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72,
+	0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x69, 0x72, 0x69, 0x6e, 0x73, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3b, 0x67, 0x6f, 0x72, 0x6d,
 }
 
 var (
@@ -1503,52 +2596,73 @@ func file_gorm_proto_rawDescGZIP() []byte {
 	return file_gorm_proto_rawDescData
 }
 
-var file_gorm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_gorm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_gorm_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_gorm_proto_goTypes = []interface{}{
-	(*GormFileOptions)(nil),           // 0: gorm.GormFileOptions
-	(*GormMessageOptions)(nil),        // 1: gorm.GormMessageOptions
-	(*ExtraField)(nil),                // 2: gorm.ExtraField
-	(*GormFieldOptions)(nil),          // 3: gorm.GormFieldOptions
-	(*GormTag)(nil),                   // 4: gorm.GormTag
-	(*HasOneOptions)(nil),             // 5: gorm.HasOneOptions
-	(*BelongsToOptions)(nil),          // 6: gorm.BelongsToOptions
-	(*HasManyOptions)(nil),            // 7: gorm.HasManyOptions
-	(*ManyToManyOptions)(nil),         // 8: gorm.ManyToManyOptions
-	(*AutoServerOptions)(nil),         // 9: gorm.AutoServerOptions
-	(*MethodOptions)(nil),             // 10: gorm.MethodOptions
-	(*descriptor.FileOptions)(nil),    // 11: google.protobuf.FileOptions
-	(*descriptor.MessageOptions)(nil), // 12: google.protobuf.MessageOptions
-	(*descriptor.FieldOptions)(nil),   // 13: google.protobuf.FieldOptions
-	(*descriptor.ServiceOptions)(nil), // 14: google.protobuf.ServiceOptions
-	(*descriptor.MethodOptions)(nil),  // 15: google.protobuf.MethodOptions
+	(EnumStorage)(0),                  // 0: gorm.EnumStorage
+	(FieldPermission)(0),              // 1: gorm.FieldPermission
+	(*GormFileOptions)(nil),           // 2: gorm.GormFileOptions
+	(*TypeMapping)(nil),               // 3: gorm.TypeMapping
+	(*GormMessageOptions)(nil),        // 4: gorm.GormMessageOptions
+	(*GormTimestamps)(nil),            // 5: gorm.GormTimestamps
+	(*GormCheck)(nil),                 // 6: gorm.GormCheck
+	(*GormIndex)(nil),                 // 7: gorm.GormIndex
+	(*GormIndexColumn)(nil),           // 8: gorm.GormIndexColumn
+	(*ExtraField)(nil),                // 9: gorm.ExtraField
+	(*GormFieldOptions)(nil),          // 10: gorm.GormFieldOptions
+	(*GormTag)(nil),                   // 11: gorm.GormTag
+	(*HasOneOptions)(nil),             // 12: gorm.HasOneOptions
+	(*BelongsToOptions)(nil),          // 13: gorm.BelongsToOptions
+	(*HasManyOptions)(nil),            // 14: gorm.HasManyOptions
+	(*ManyToManyOptions)(nil),         // 15: gorm.ManyToManyOptions
+	(*GormOneofOptions)(nil),          // 16: gorm.GormOneofOptions
+	(*AutoServerOptions)(nil),         // 17: gorm.AutoServerOptions
+	(*MethodOptions)(nil),             // 18: gorm.MethodOptions
+	(*descriptor.FileOptions)(nil),    // 19: google.protobuf.FileOptions
+	(*descriptor.MessageOptions)(nil), // 20: google.protobuf.MessageOptions
+	(*descriptor.FieldOptions)(nil),   // 21: google.protobuf.FieldOptions
+	(*descriptor.OneofOptions)(nil),   // 22: google.protobuf.OneofOptions
+	(*descriptor.ServiceOptions)(nil), // 23: google.protobuf.ServiceOptions
+	(*descriptor.MethodOptions)(nil),  // 24: google.protobuf.MethodOptions
 }
 var file_gorm_proto_depIdxs = []int32{
-	2,  // 0: gorm.GormMessageOptions.include:type_name -> gorm.ExtraField
-	4,  // 1: gorm.ExtraField.tag:type_name -> gorm.GormTag
-	4,  // 2: gorm.GormFieldOptions.tag:type_name -> gorm.GormTag
-	5,  // 3: gorm.GormFieldOptions.has_one:type_name -> gorm.HasOneOptions
-	6,  // 4: gorm.GormFieldOptions.belongs_to:type_name -> gorm.BelongsToOptions
-	7,  // 5: gorm.GormFieldOptions.has_many:type_name -> gorm.HasManyOptions
-	8,  // 6: gorm.GormFieldOptions.many_to_many:type_name -> gorm.ManyToManyOptions
-	4,  // 7: gorm.HasOneOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 8: gorm.BelongsToOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 9: gorm.HasManyOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 10: gorm.HasManyOptions.position_field_tag:type_name -> gorm.GormTag
-	11, // 11: gorm.file_opts:extendee -> google.protobuf.FileOptions
-	12, // 12: gorm.opts:extendee -> google.protobuf.MessageOptions
-	13, // 13: gorm.field:extendee -> google.protobuf.FieldOptions
-	14, // 14: gorm.server:extendee -> google.protobuf.ServiceOptions
-	15, // 15: gorm.method:extendee -> google.protobuf.MethodOptions
-	0,  // 16: gorm.file_opts:type_name -> gorm.GormFileOptions
-	1,  // 17: gorm.opts:type_name -> gorm.GormMessageOptions
-	3,  // 18: gorm.field:type_name -> gorm.GormFieldOptions
-	9,  // 19: gorm.server:type_name -> gorm.AutoServerOptions
-	10, // 20: gorm.method:type_name -> gorm.MethodOptions
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	16, // [16:21] is the sub-list for extension type_name
-	11, // [11:16] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	0,  // 0: gorm.GormFileOptions.enum_storage:type_name -> gorm.EnumStorage
+	3,  // 1: gorm.GormFileOptions.type_mapping:type_name -> gorm.TypeMapping
+	9,  // 2: gorm.GormMessageOptions.include:type_name -> gorm.ExtraField
+	7,  // 3: gorm.GormMessageOptions.indexes:type_name -> gorm.GormIndex
+	6,  // 4: gorm.GormMessageOptions.checks:type_name -> gorm.GormCheck
+	5,  // 5: gorm.GormMessageOptions.timestamps:type_name -> gorm.GormTimestamps
+	8,  // 6: gorm.GormIndex.columns:type_name -> gorm.GormIndexColumn
+	11, // 7: gorm.ExtraField.tag:type_name -> gorm.GormTag
+	11, // 8: gorm.GormFieldOptions.tag:type_name -> gorm.GormTag
+	12, // 9: gorm.GormFieldOptions.has_one:type_name -> gorm.HasOneOptions
+	13, // 10: gorm.GormFieldOptions.belongs_to:type_name -> gorm.BelongsToOptions
+	14, // 11: gorm.GormFieldOptions.has_many:type_name -> gorm.HasManyOptions
+	15, // 12: gorm.GormFieldOptions.many_to_many:type_name -> gorm.ManyToManyOptions
+	0,  // 13: gorm.GormFieldOptions.enum_storage:type_name -> gorm.EnumStorage
+	1,  // 14: gorm.GormFieldOptions.permission:type_name -> gorm.FieldPermission
+	11, // 15: gorm.HasOneOptions.foreignkey_tag:type_name -> gorm.GormTag
+	11, // 16: gorm.BelongsToOptions.foreignkey_tag:type_name -> gorm.GormTag
+	11, // 17: gorm.HasManyOptions.foreignkey_tag:type_name -> gorm.GormTag
+	11, // 18: gorm.HasManyOptions.position_field_tag:type_name -> gorm.GormTag
+	11, // 19: gorm.GormOneofOptions.tag:type_name -> gorm.GormTag
+	19, // 20: gorm.file_opts:extendee -> google.protobuf.FileOptions
+	20, // 21: gorm.opts:extendee -> google.protobuf.MessageOptions
+	21, // 22: gorm.field:extendee -> google.protobuf.FieldOptions
+	22, // 23: gorm.oneof:extendee -> google.protobuf.OneofOptions
+	23, // 24: gorm.server:extendee -> google.protobuf.ServiceOptions
+	24, // 25: gorm.method:extendee -> google.protobuf.MethodOptions
+	2,  // 26: gorm.file_opts:type_name -> gorm.GormFileOptions
+	4,  // 27: gorm.opts:type_name -> gorm.GormMessageOptions
+	10, // 28: gorm.field:type_name -> gorm.GormFieldOptions
+	16, // 29: gorm.oneof:type_name -> gorm.GormOneofOptions
+	17, // 30: gorm.server:type_name -> gorm.AutoServerOptions
+	18, // 31: gorm.method:type_name -> gorm.MethodOptions
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	26, // [26:32] is the sub-list for extension type_name
+	20, // [20:26] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_gorm_proto_init() }
@@ -1570,7 +2684,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormMessageOptions); i {
+			switch v := v.(*TypeMapping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1582,7 +2696,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtraField); i {
+			switch v := v.(*GormMessageOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1594,7 +2708,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormFieldOptions); i {
+			switch v := v.(*GormTimestamps); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1606,7 +2720,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormTag); i {
+			switch v := v.(*GormCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1618,7 +2732,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasOneOptions); i {
+			switch v := v.(*GormIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1630,7 +2744,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BelongsToOptions); i {
+			switch v := v.(*GormIndexColumn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1642,7 +2756,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasManyOptions); i {
+			switch v := v.(*ExtraField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1654,7 +2768,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ManyToManyOptions); i {
+			switch v := v.(*GormFieldOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1666,7 +2780,7 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoServerOptions); i {
+			switch v := v.(*GormTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1678,6 +2792,78 @@ func file_gorm_proto_init() {
 			}
 		}
 		file_gorm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasOneOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gorm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BelongsToOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gorm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasManyOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gorm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManyToManyOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gorm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GormOneofOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gorm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoServerOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gorm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MethodOptions); i {
 			case 0:
 				return &v.state
@@ -1690,7 +2876,7 @@ func file_gorm_proto_init() {
 			}
 		}
 	}
-	file_gorm_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_gorm_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*GormFieldOptions_HasOne)(nil),
 		(*GormFieldOptions_BelongsTo)(nil),
 		(*GormFieldOptions_HasMany)(nil),
@@ -1701,13 +2887,14 @@ func file_gorm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gorm_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
-			NumExtensions: 5,
+			NumEnums:      2,
+			NumMessages:   17,
+			NumExtensions: 6,
 			NumServices:   0,
 		},
 		GoTypes:           file_gorm_proto_goTypes,
 		DependencyIndexes: file_gorm_proto_depIdxs,
+		EnumInfos:         file_gorm_proto_enumTypes,
 		MessageInfos:      file_gorm_proto_msgTypes,
 		ExtensionInfos:    file_gorm_proto_extTypes,
 	}.Build()
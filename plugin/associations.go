@@ -2,7 +2,6 @@ package plugin
 
 import (
 	"fmt"
-	jgorm "github.com/jinzhu/gorm"
 	"github.com/jinzhu/inflection"
 	"github.com/kirinse/atlas-app-toolkit/util/cases"
 	gorm "github.com/kirinse/protoc-gen-gorm/options"
@@ -16,16 +15,14 @@ func (p *OrmPlugin) parseAssociations(msg *protogen.Message) {
 
 	for _, field := range msg.Fields {
 		fieldOpts := getFieldOptions(field)
-		if fieldOpts.GetDrop() {
+		if fieldOpts.GetDrop() || isOneofField(field) && oneofAsJSON(field.Oneof) {
 			continue
 		}
 		fieldName := fieldName(field)
 		// tmp := protodesc.ToFieldDescriptorProto(field)
 		var fieldType string
-		if field.Desc.Message() != nil {
-			tmp := string(field.Desc.Message().Name())
-			parts := strings.Split(tmp, ".")
-			fieldType = parts[len(parts)-1]
+		if field.Message != nil {
+			fieldType = p.messageType(field.Message)
 		} else {
 			fieldType = field.Desc.Kind().String()
 		}
@@ -70,23 +67,23 @@ func (p *OrmPlugin) parseHasMany(msg *protogen.Message, parent *OrmableType, fie
 		hasMany = &gorm.HasManyOptions{}
 		opts.Association = &gorm.GormFieldOptions_HasMany{HasMany: hasMany}
 	}
-	assocKeyName, assocKey := p.getAssocKeyName(hasMany, parent)
-	hasMany.AssociationForeignkey = &assocKeyName
-	foreignKey := p.getForeignKey(hasMany, assocKey, child, field)
-	var foreignKeyName string
-	if foreignKeyName = hasMany.GetForeignkey(); foreignKeyName == "" {
-		if p.countHasAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
-		}
+	assocKeyNames, assocKeys := p.getAssocKeyNames(hasMany, parent)
+	p.checkPreloadKeys(parent, fieldName, assocKeyNames)
+	prefix := typeName
+	if p.countHasAssociationDimension(msg, fieldType) != 1 {
+		prefix = fieldName + typeName
 	}
-	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
-		p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-many in`, parent.Name, `since it`,
-			`does not have FK`, foreignKeyName, `defined. Manually define the key, or switch to many-to-many`)
+	foreignKeyNames := p.getForeignKeyNames(hasMany.GetForeignkey(), prefix, assocKeyNames, parent, fieldName)
+	for i, foreignKeyName := range foreignKeyNames {
+		if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
+			p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-many in`, parent.Name, `since it`,
+				`does not have FK`, foreignKeyName, `defined. Manually define the key, or switch to many-to-many`)
+		}
+		foreignKey := p.getForeignKey(hasMany, assocKeys[i], child, field)
+		p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
 	}
-	hasMany.Foreignkey = &foreignKeyName
-	p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
+	hasMany.AssociationForeignkey = joinKeyNames(assocKeyNames)
+	hasMany.Foreignkey = joinKeyNames(foreignKeyNames)
 
 	var posField string
 	if posField = cases.GoCamelCase(hasMany.GetPositionField()); posField != "" {
@@ -106,23 +103,23 @@ func (p *OrmPlugin) parseHasOne(msg *protogen.Message, parent *OrmableType, fiel
 		hasOne = &gorm.HasOneOptions{}
 		opts.Association = &gorm.GormFieldOptions_HasOne{HasOne: hasOne}
 	}
-	assocKeyName, assocKey := p.getAssocKeyName(hasOne, parent)
-	hasOne.AssociationForeignkey = &assocKeyName
-	foreignKey := p.getForeignKey(hasOne, assocKey, child, field)
-	foreignKeyName := cases.GoCamelCase(hasOne.GetForeignkey())
-	if foreignKeyName == "" {
-		if p.countHasAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
-		}
+	assocKeyNames, assocKeys := p.getAssocKeyNames(hasOne, parent)
+	p.checkPreloadKeys(parent, fieldName, assocKeyNames)
+	prefix := typeName
+	if p.countHasAssociationDimension(msg, fieldType) != 1 {
+		prefix = fieldName + typeName
 	}
-	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
-		p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-one in`, parent.Name, `since it`,
-			`does not have FK field`, foreignKeyName, `defined. Manually define the key, or switch to belongs-to`)
+	foreignKeyNames := p.getForeignKeyNames(hasOne.GetForeignkey(), prefix, assocKeyNames, parent, fieldName)
+	for i, foreignKeyName := range foreignKeyNames {
+		if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
+			p.Fail(`Object`, child.Name, `from package`, child.Package, `cannot be used for has-one in`, parent.Name, `since it`,
+				`does not have FK field`, foreignKeyName, `defined. Manually define the key, or switch to belongs-to`)
+		}
+		foreignKey := p.getForeignKey(hasOne, assocKeys[i], child, field)
+		p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
 	}
-	hasOne.Foreignkey = &foreignKeyName
-	p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
+	hasOne.AssociationForeignkey = joinKeyNames(assocKeyNames)
+	hasOne.Foreignkey = joinKeyNames(foreignKeyNames)
 }
 
 func (p *OrmPlugin) parseBelongsTo(msg *protogen.Message, child *OrmableType, fieldName string, fieldType string, field *protogen.Field, parent *OrmableType, opts *gorm.GormFieldOptions) {
@@ -131,19 +128,19 @@ func (p *OrmPlugin) parseBelongsTo(msg *protogen.Message, child *OrmableType, fi
 		belongsTo = &gorm.BelongsToOptions{}
 		opts.Association = &gorm.GormFieldOptions_BelongsTo{BelongsTo: belongsTo}
 	}
-	assocKeyName, assocKey := p.getAssocKeyName(belongsTo, parent)
-	belongsTo.AssociationForeignkey = &assocKeyName
-	foreignKey := p.getForeignKey(belongsTo, assocKey, child, field)
-	foreignKeyName := cases.GoCamelCase(belongsTo.GetForeignkey())
-	if foreignKeyName == "" {
-		if p.countBelongsToAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(fieldType + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + assocKeyName)
-		}
+	assocKeyNames, assocKeys := p.getAssocKeyNames(belongsTo, parent)
+	p.checkPreloadKeys(child, fieldName, assocKeyNames)
+	prefix := fieldType
+	if p.countBelongsToAssociationDimension(msg, fieldType) != 1 {
+		prefix = fieldName
 	}
-	belongsTo.Foreignkey = &foreignKeyName
-	p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
+	foreignKeyNames := p.getForeignKeyNames(belongsTo.GetForeignkey(), prefix, assocKeyNames, child, fieldName)
+	for i, foreignKeyName := range foreignKeyNames {
+		foreignKey := p.getForeignKey(belongsTo, assocKeys[i], child, field)
+		p.setChildForeignKeyFieldExternal(child, parent, foreignKey, foreignKeyName)
+	}
+	belongsTo.AssociationForeignkey = joinKeyNames(assocKeyNames)
+	belongsTo.Foreignkey = joinKeyNames(foreignKeyNames)
 }
 
 func (p *OrmPlugin) parseManyToMany(msg *protogen.Message, ormable *OrmableType, fieldName string, fieldType string, field *protogen.Field, assoc *OrmableType, opts *gorm.GormFieldOptions) {
@@ -153,58 +150,112 @@ func (p *OrmPlugin) parseManyToMany(msg *protogen.Message, ormable *OrmableType,
 		mtm = &gorm.ManyToManyOptions{}
 		opts.Association = &gorm.GormFieldOptions_ManyToMany{ManyToMany: mtm}
 	}
-	var foreignKeyName string
-	if foreignKeyName = cases.GoCamelCase(mtm.GetForeignkey()); foreignKeyName == "" {
-		foreignKeyName, _ = p.findPrimaryKey(ormable)
-	} else {
-		var ok bool
-		_, ok = ormable.Fields[foreignKeyName]
-		if !ok {
+	foreignKeyNames := splitKeyNames(mtm.GetForeignkey())
+	if len(foreignKeyNames) == 0 {
+		foreignKeyNames, _ = p.findPrimaryKeys(ormable)
+	}
+	for _, foreignKeyName := range foreignKeyNames {
+		if _, ok := ormable.Fields[foreignKeyName]; !ok {
 			p.Fail("Missing", foreignKeyName, "field in", ormable.Name, ".")
 		}
 	}
-	mtm.Foreignkey = &foreignKeyName
-	assocKeyName, _ := p.getAssocKeyName(mtm, assoc)
-	mtm.AssociationForeignkey = &assocKeyName
+	mtm.Foreignkey = joinKeyNames(foreignKeyNames)
+	assocKeyNames, _ := p.getAssocKeyNames(mtm, assoc)
+	p.checkPreloadKeys(ormable, fieldName, foreignKeyNames)
+	p.checkPreloadKeys(ormable, fieldName, assocKeyNames)
+	mtm.AssociationForeignkey = joinKeyNames(assocKeyNames)
 	var jt string
-	if jt = jgorm.ToDBName(mtm.GetJointable()); jt == "" {
+	if jt = naming.ColumnName("", mtm.GetJointable()); jt == "" {
+		fileOpts := getFileOptions(msg.Desc.ParentFile())
+		joined := fieldName
 		if p.countManyToManyAssociationDimension(msg, fieldType) == 1 && typeName != fieldType {
-			jt = jgorm.ToDBName(typeName + inflection.Plural(fieldType))
+			joined = fieldType
+		}
+		if fileOpts.GetSingularTable() {
+			joined = inflection.Singular(joined)
 		} else {
-			jt = jgorm.ToDBName(typeName + inflection.Plural(fieldName))
+			joined = inflection.Plural(joined)
 		}
+		jt = qualifiedTableName(fileOpts, fileOpts.GetTablePrefix()+naming.ColumnName("", typeName+joined))
 	}
 	mtm.Jointable = &jt
-	var jtForeignKey string
-	if jtForeignKey = cases.GoCamelCase(mtm.GetJointableForeignkey()); jtForeignKey == "" {
-		jtForeignKey = jgorm.ToDBName(typeName + foreignKeyName)
-	}
-	mtm.JointableForeignkey = &jtForeignKey
-	var jtAssocForeignKey string
-	if jtAssocForeignKey = cases.GoCamelCase(mtm.GetAssociationJointableForeignkey()); jtAssocForeignKey == "" {
-		if typeName == fieldType {
-			jtAssocForeignKey = jgorm.ToDBName(inflection.Singular(fieldName) + assocKeyName)
-		} else {
-			jtAssocForeignKey = jgorm.ToDBName(fieldType + assocKeyName)
+	jtForeignKeys := splitKeyNames(mtm.GetJointableForeignkey())
+	if len(jtForeignKeys) == 0 {
+		for _, foreignKeyName := range foreignKeyNames {
+			jtForeignKeys = append(jtForeignKeys, typeName+foreignKeyName)
 		}
+	} else if len(jtForeignKeys) != len(foreignKeyNames) {
+		p.Fail("Many-to-many", fieldName, "of", ormable.Name, "has", fmt.Sprint(len(jtForeignKeys)), "jointable foreign keys for",
+			fmt.Sprint(len(foreignKeyNames)), "foreign keys.")
 	}
-	mtm.AssociationJointableForeignkey = &jtAssocForeignKey
+	mtm.JointableForeignkey = joinKeyNames(jtForeignKeys)
+	jtAssocForeignKeys := splitKeyNames(mtm.GetAssociationJointableForeignkey())
+	if len(jtAssocForeignKeys) == 0 {
+		for _, assocKeyName := range assocKeyNames {
+			if typeName == fieldType {
+				jtAssocForeignKeys = append(jtAssocForeignKeys, inflection.Singular(fieldName)+assocKeyName)
+			} else {
+				jtAssocForeignKeys = append(jtAssocForeignKeys, fieldType+assocKeyName)
+			}
+		}
+	} else if len(jtAssocForeignKeys) != len(assocKeyNames) {
+		p.Fail("Many-to-many", fieldName, "of", ormable.Name, "has", fmt.Sprint(len(jtAssocForeignKeys)), "association jointable foreign keys for",
+			fmt.Sprint(len(assocKeyNames)), "association keys.")
+	}
+	mtm.AssociationJointableForeignkey = joinKeyNames(jtAssocForeignKeys)
 }
 
 type assocForeignKeyGetter interface {
 	GetAssociationForeignkey() string
 }
 
-func (p *OrmPlugin) getAssocKeyName(i assocForeignKeyGetter, parent *OrmableType) (string, *Field) {
-	assocKeyName := cases.GoCamelCase(i.GetAssociationForeignkey())
-	if assocKeyName == "" {
-		return p.findPrimaryKey(parent)
+// getAssocKeyNames returns the keys of the parent the association references,
+// the association_foreignkey option or else the primary keys of the parent
+func (p *OrmPlugin) getAssocKeyNames(i assocForeignKeyGetter, parent *OrmableType) ([]string, []*Field) {
+	assocKeyNames := splitKeyNames(i.GetAssociationForeignkey())
+	if len(assocKeyNames) == 0 {
+		return p.findPrimaryKeys(parent)
+	}
+	assocKeys := make([]*Field, len(assocKeyNames))
+	for j, assocKeyName := range assocKeyNames {
+		assocKey, ok := parent.Fields[assocKeyName]
+		if !ok {
+			p.Fail("Missing", assocKeyName, "field in", parent.Name, ".")
+		}
+		assocKeys[j] = assocKey
+	}
+	return assocKeyNames, assocKeys
+}
+
+// checkPreloadKeys fails the associations matched by composite keys on SQLite,
+// GORM preloads them with lists of row values SQLite doesn't support
+func (p *OrmPlugin) checkPreloadKeys(ormable *OrmableType, fieldName string, keyNames []string) {
+	if p.Dialect == dialectSQLite && len(keyNames) > 1 {
+		p.Fail("Association", fieldName, "of", ormable.Name, "is matched by the composite key", strings.Join(keyNames, ", "),
+			"which GORM cannot preload on sqlite.")
 	}
-	assocKey, ok := parent.Fields[assocKeyName]
-	if !ok {
-		p.Fail("Missing", assocKeyName, "field in", parent.Name, ".")
+}
+
+// getForeignKeyNames returns the foreign keys matching the association keys,
+// the foreignkey option or else the association keys prefixed with prefix
+func (p *OrmPlugin) getForeignKeyNames(foreignKey, prefix string, assocKeyNames []string, owner *OrmableType, fieldName string) []string {
+	foreignKeyNames := splitKeyNames(foreignKey)
+	if len(foreignKeyNames) == 0 {
+		for _, assocKeyName := range assocKeyNames {
+			foreignKeyNames = append(foreignKeyNames, prefix+assocKeyName)
+		}
+	} else if len(foreignKeyNames) != len(assocKeyNames) {
+		p.Fail("Association", fieldName, "of", owner.Name, "has", fmt.Sprint(len(foreignKeyNames)), "foreign keys for",
+			fmt.Sprint(len(assocKeyNames)), "association keys.")
 	}
-	return assocKeyName, assocKey
+	return foreignKeyNames
+}
+
+// joinKeyNames is the value of an association key option, the key names
+// separated by commas as GORM expects them for composite keys
+func joinKeyNames(names []string) *string {
+	joined := strings.Join(names, ",")
+	return &joined
 }
 
 type foreignKeyTagGetter interface {
@@ -243,42 +294,92 @@ func (p *OrmPlugin) setChildForeignKeyFieldExternal(child *OrmableType, parent *
 	child.Fields[foreignKeyName].ParentOriginName = parent.OriginName
 }
 
-func (p *OrmPlugin) findPrimaryKeyHelper(ormable *OrmableType) (bool, string, *Field) {
-	for fieldName, field := range ormable.Fields {
-		if field.GetTag().GetPrimaryKey() {
-			return true, fieldName, field
+// declaredFieldNames lists the fields of the ormable in declaration order,
+// the message fields, then the included fields, then the fields added for
+// associations sorted by name
+func (p *OrmPlugin) declaredFieldNames(ormable *OrmableType) []string {
+	var names []string
+	seen := map[string]bool{}
+	add := func(name string) {
+		if _, ok := ormable.Fields[name]; ok && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
 		}
 	}
-
-	for fieldName, field := range ormable.Fields {
-		if strings.ToLower(fieldName) == "id" {
-			return true, fieldName, field
+	if ormable.Message != nil {
+		for _, field := range ormable.Message.Fields {
+			add(fieldName(field))
+		}
+		for _, field := range getMessageOptions(ormable.Message).GetInclude() {
+			add(cases.GoCamelCase(field.GetName()))
 		}
 	}
+	for _, name := range p.getSortedFieldNames(ormable.Fields) {
+		add(name)
+	}
+	return names
+}
 
-	// get PrimaryKey from embedded
-	//for _, field := range ormable.Fields {
-	//	fmt.Println("##### try to get PrimaryKey from embedded:", field.GetTag().GetEmbedded())
-	//	if field.GetTag().GetEmbedded() {
-	//		typeName := field.Type
-	//		embedOrmable := p.getOrmable(typeName)
-	//		return p.findPrimaryKeyHelper(embedOrmable)
-	//	}
-	//}
-	return false, "", nil
+// primaryKeyNames lists the primary key fields of the ormable in declaration
+// order, the fields tagged primary_key or else the id field
+func (p *OrmPlugin) primaryKeyNames(ormable *OrmableType) []string {
+	var keys []string
+	names := p.declaredFieldNames(ormable)
+	for _, name := range names {
+		if ormable.Fields[name].GetTag().GetPrimaryKey() {
+			keys = append(keys, name)
+		}
+	}
+	if len(keys) > 0 {
+		return keys
+	}
+	for _, name := range names {
+		if strings.ToLower(name) == "id" {
+			return []string{name}
+		}
+	}
+	return nil
 }
 
-func (p *OrmPlugin) findPrimaryKey(ormable *OrmableType) (string, *Field) {
-	found, a, b := p.findPrimaryKeyHelper(ormable)
-	if !found {
+func (p *OrmPlugin) findPrimaryKeys(ormable *OrmableType) ([]string, []*Field) {
+	names := p.primaryKeyNames(ormable)
+	if len(names) == 0 {
 		p.Fail("Primary key cannot be found in", ormable.Name, ".")
 	}
-	return a, b
+	fields := make([]*Field, len(names))
+	for i, name := range names {
+		fields[i] = ormable.Fields[name]
+	}
+	return names, fields
 }
 
 func (p *OrmPlugin) hasPrimaryKey(ormable *OrmableType) bool {
-	found, _, _ := p.findPrimaryKeyHelper(ormable)
-	return found
+	return len(p.primaryKeyNames(ormable)) > 0
+}
+
+// hasCompositePrimaryKey tells if the ormable is identified by several keys
+func (p *OrmPlugin) hasCompositePrimaryKey(ormable *OrmableType) bool {
+	return len(p.primaryKeyNames(ormable)) > 1
+}
+
+// columnName is the column of a field
+func columnName(name string, field *Field) string {
+	if column := field.GetTag().GetColumn(); column != "" {
+		return column
+	}
+	return naming.ColumnName("", name)
+}
+
+// splitKeyNames splits the comma separated field names of an association key
+func splitKeyNames(names string) []string {
+	if names == "" {
+		return nil
+	}
+	keys := strings.Split(names, ",")
+	for i, key := range keys {
+		keys[i] = cases.GoCamelCase(strings.TrimSpace(key))
+	}
+	return keys
 }
 
 func (p *OrmPlugin) countDimensionGeneric(msg *protogen.Message, typeName string, conditional func(fieldOpts *gorm.GormFieldOptions) bool) int {
@@ -329,3 +430,36 @@ func (p *OrmPlugin) sameType(field1 *Field, field2 *Field) bool {
 	}
 	return field1.Type == field2.Type
 }
+
+// omitAssociations returns the Omit clause of the associations the default
+// create or update handler must not save, GORM v2 has no tag for the
+// association_autocreate and association_autoupdate options
+func (p *OrmPlugin) omitAssociations(ormable *OrmableType, create bool) string {
+	var omitted []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		var autocreate, autoupdate *bool
+		if hasOne := field.GetHasOne(); hasOne != nil {
+			autocreate, autoupdate = hasOne.AssociationAutocreate, hasOne.AssociationAutoupdate
+		} else if belongsTo := field.GetBelongsTo(); belongsTo != nil {
+			autocreate, autoupdate = belongsTo.AssociationAutocreate, belongsTo.AssociationAutoupdate
+		} else if hasMany := field.GetHasMany(); hasMany != nil {
+			autocreate, autoupdate = hasMany.AssociationAutocreate, hasMany.AssociationAutoupdate
+		} else if mtm := field.GetManyToMany(); mtm != nil {
+			autocreate, autoupdate = mtm.AssociationAutocreate, mtm.AssociationAutoupdate
+		} else if tag := field.GetTag(); tag != nil {
+			autocreate, autoupdate = tag.AssociationAutocreate, tag.AssociationAutoupdate
+		}
+		autoSave := autoupdate
+		if create {
+			autoSave = autocreate
+		}
+		if autoSave != nil && !*autoSave {
+			omitted = append(omitted, `"`+fieldName+`"`)
+		}
+	}
+	if len(omitted) == 0 {
+		return ""
+	}
+	return ".Omit(" + strings.Join(omitted, ", ") + ")"
+}
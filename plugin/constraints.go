@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// constraintName matches the names GORM accepts for constraints
+var constraintName = regexp.MustCompile("^[A-Za-z-_]+$")
+
+// checkIdentifier and checkLiteral match the identifiers and the string
+// literals of a check expression
+var (
+	checkIdentifier = regexp.MustCompile("[A-Za-z_][A-Za-z0-9_]*")
+	checkLiteral    = regexp.MustCompile(`'(?:[^']|'')*'`)
+)
+
+// referentialActions are the actions of a foreign key constraint
+var referentialActions = []string{"CASCADE", "SET NULL", "SET DEFAULT", "RESTRICT", "NO ACTION"}
+
+// parseConstraints validates the check constraints of the message and adds
+// the foreign key actions to the constraints of its associations, a column
+// holds a single check so each message level one goes to the first column it
+// references that has none
+func (p *OrmPlugin) parseConstraints(message *protogen.Message) {
+	ormable := p.getOrmableMessage(message)
+	var columns []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		if hasOne := field.GetHasOne(); hasOne != nil {
+			hasOne.Constraint = p.associationConstraint(ormable, fieldName, hasOne.Constraint, hasOne.GetOnDelete(), hasOne.GetOnUpdate())
+		} else if belongsTo := field.GetBelongsTo(); belongsTo != nil {
+			belongsTo.Constraint = p.associationConstraint(ormable, fieldName, belongsTo.Constraint, belongsTo.GetOnDelete(), belongsTo.GetOnUpdate())
+		} else if hasMany := field.GetHasMany(); hasMany != nil {
+			hasMany.Constraint = p.associationConstraint(ormable, fieldName, hasMany.Constraint, hasMany.GetOnDelete(), hasMany.GetOnUpdate())
+		} else if mtm := field.GetManyToMany(); mtm != nil {
+			mtm.Constraint = p.associationConstraint(ormable, fieldName, mtm.Constraint, mtm.GetOnDelete(), mtm.GetOnUpdate())
+		} else if check := field.GetTag().GetCheck(); check != "" {
+			p.checkConstraintValue(ormable, fieldName, check)
+		} else if !field.GetTag().GetEmbedded() && !field.GetTag().GetIgnore() {
+			columns = append(columns, fieldName)
+		}
+	}
+	names := make(map[string]struct{})
+	for _, check := range getMessageOptions(message).GetChecks() {
+		if !constraintName.MatchString(check.GetName()) {
+			p.Fail("check", check.GetName(), "of", ormable.OriginName, "must be named with letters, - and _ only")
+		}
+		if _, ok := names[check.GetName()]; ok {
+			p.Fail("check", check.GetName(), "of", ormable.OriginName, "is declared twice")
+		}
+		names[check.GetName()] = struct{}{}
+		p.checkConstraintValue(ormable, check.GetName(), check.GetExpression())
+		i := checkColumn(ormable, columns, check.GetExpression())
+		if i < 0 {
+			p.Fail("check", check.GetName(), "of", ormable.OriginName, "references no column without a check")
+		}
+		ormable.Fields[columns[i]].Check = check.GetName() + "," + check.GetExpression()
+		columns = append(columns[:i], columns[i+1:]...)
+	}
+}
+
+// checkColumn is the index of the first of the columns referenced by the
+// check expression, -1 if it references none of them
+func checkColumn(ormable *OrmableType, columns []string, expression string) int {
+	referenced := make(map[string]struct{})
+	for _, identifier := range checkIdentifier.FindAllString(checkLiteral.ReplaceAllString(expression, ""), -1) {
+		referenced[strings.ToLower(identifier)] = struct{}{}
+	}
+	for i, fieldName := range columns {
+		if _, ok := referenced[strings.ToLower(columnName(fieldName, ormable.Fields[fieldName]))]; ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkConstraintValue fails on a value the gorm tag can't hold
+func (p *OrmPlugin) checkConstraintValue(ormable *OrmableType, name, value string) {
+	if strings.ContainsAny(value, ";`") {
+		p.Fail("check", name, "of", ormable.OriginName, "has a semicolon or backquote in", value)
+	}
+}
+
+// associationConstraint adds the on_delete and on_update actions to the
+// constraint of an association
+func (p *OrmPlugin) associationConstraint(ormable *OrmableType, fieldName string, constraint *string, onDelete, onUpdate string) *string {
+	if onDelete == "" && onUpdate == "" {
+		return constraint
+	}
+	var settings []string
+	if constraint != nil {
+		if *constraint == "-" {
+			p.Fail("association", fieldName, "of", ormable.OriginName, "has on_delete or on_update without a constraint")
+		}
+		settings = append(settings, *constraint)
+	}
+	upper := strings.ToUpper(strings.Join(settings, ","))
+	for _, action := range []struct{ key, value string }{{"OnDelete", onDelete}, {"OnUpdate", onUpdate}} {
+		if action.value == "" {
+			continue
+		}
+		value := strings.ToUpper(strings.Join(strings.Fields(action.value), " "))
+		if !containsString(referentialActions, value) {
+			p.Fail("association", fieldName, "of", ormable.OriginName, "has unknown", action.key, "action", action.value)
+		}
+		if strings.Contains(upper, strings.ToUpper(action.key)+":") {
+			p.Fail("association", fieldName, "of", ormable.OriginName, "sets", action.key, "in its constraint too")
+		}
+		settings = append(settings, action.key+":"+value)
+	}
+	res := strings.Join(settings, ",")
+	return &res
+}
+
+// escapeTagValue escapes the backslashes and quotes of a value of the struct tag
+func escapeTagValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return strings.ReplaceAll(value, `"`, `\"`)
+}
@@ -0,0 +1,88 @@
+package plugin
+
+import (
+	"strings"
+
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Dialects of the database the generated code runs against
+const (
+	dialectPostgres = "postgres"
+	dialectMySQL    = "mysql"
+	dialectSQLite   = "sqlite"
+)
+
+// checkDialect validates the dialect plugin parameter, postgres by default
+func (p *OrmPlugin) checkDialect() {
+	switch p.Dialect {
+	case "":
+		p.Dialect = dialectPostgres
+	case dialectPostgres, dialectMySQL, dialectSQLite:
+	default:
+		p.Fail("unknown dialect", p.Dialect, "expected one of postgres, mysql or sqlite")
+	}
+}
+
+func (p *OrmPlugin) isPostgres() bool {
+	return p.Dialect == dialectPostgres
+}
+
+// isJSONArray tells if the repeated scalar or enum field is stored as a JSON
+// array, databases other than Postgres have no array columns
+func (p *OrmPlugin) isJSONArray(field *protogen.Field) bool {
+	if p.isPostgres() || !field.Desc.IsList() {
+		return false
+	}
+	return field.Desc.Enum() != nil || p.IsAbleToMakePQArray(p.fieldType(field))
+}
+
+// inetColumnType is the column type of an ip address and netmask
+func (p *OrmPlugin) inetColumnType() string {
+	switch p.Dialect {
+	case dialectMySQL:
+		return "varchar(43)"
+	case dialectSQLite:
+		return "text"
+	default:
+		return "inet"
+	}
+}
+
+// decimalColumnType is the column type of a decimal, SQLite keeps numeric
+// values as REAL when it can so they are stored as text there, none when the
+// precision is left to the database
+func (p *OrmPlugin) decimalColumnType(tag *gorm.GormTag) string {
+	switch {
+	case p.Dialect == dialectSQLite:
+		return "text"
+	case tag.GetPrecision() > 0:
+		return numericColumnType(tag)
+	default:
+		return ""
+	}
+}
+
+// mysqlEnumType is the inline MySQL column type holding the enum value names
+func mysqlEnumType(enum *protogen.Enum) string {
+	return "enum(" + enumValueList(enum, ",") + ")"
+}
+
+// enumValueList is the quoted value names of the enum joined by sep
+func enumValueList(enum *protogen.Enum, sep string) string {
+	values := make([]string, len(enum.Values))
+	for i, value := range enum.Values {
+		values[i] = "'" + string(value.Desc.Name()) + "'"
+	}
+	return strings.Join(values, sep)
+}
+
+// lockingClause returns the clause locking the rows read in a transaction,
+// SQLite has no row locks and locks the whole database on write instead
+func (p *OrmPlugin) lockingClause() []interface{} {
+	if p.Dialect == dialectSQLite {
+		return nil
+	}
+	return []interface{}{`.Clauses(`, identClauseLocking, `{Strength: "UPDATE"})`}
+}
@@ -0,0 +1,227 @@
+package plugin
+
+import (
+	"strings"
+
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// isEmbeddedMessage tells if the field holds a non-ormable message flattened
+// into prefixed columns of the parent table
+func (p *OrmPlugin) isEmbeddedMessage(field *protogen.Field) bool {
+	return field.Message != nil && !field.Desc.IsList() && !field.Desc.IsMap() && !isOneofField(field) &&
+		getFieldOptions(field).GetTag().GetEmbedded() && !p.isOrmable(p.fieldType(field))
+}
+
+// embeddedColumnPrefix is the prefix of the columns of an embedded message,
+// the embedded_prefix tag or the column name of the field and an underscore
+func embeddedColumnPrefix(field *protogen.Field) string {
+	if tag := getFieldOptions(field).GetTag(); tag.EmbeddedPrefix != nil {
+		return tag.GetEmbeddedPrefix()
+	}
+	return naming.ColumnName("", field.GoName) + "_"
+}
+
+// embeddedFieldName is the name of the ORM field holding a member of an
+// embedded message, e.g. BillingStreet for the Street member of Billing
+func embeddedFieldName(field, member *protogen.Field) string {
+	return field.GoName + member.GoName
+}
+
+// embeddedMembers lists the members of an embedded message which are stored
+// in their own column
+func (p *OrmPlugin) embeddedMembers(field *protogen.Field) []*protogen.Field {
+	var members []*protogen.Field
+	for _, member := range field.Message.Fields {
+		if getFieldOptions(member).GetDrop() {
+			continue
+		}
+		if _, ok := p.embeddedMemberIdent(member); ok {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// embeddedMemberIdent is the ORM type of a member of an embedded message,
+// only scalars, enums, wrappers and timestamps can be flattened
+func (p *OrmPlugin) embeddedMemberIdent(member *protogen.Field) (protogen.GoIdent, bool) {
+	desc := member.Desc
+	var ident protogen.GoIdent
+	switch {
+	case desc.IsList() || desc.IsMap() || isOneofField(member):
+		return ident, false
+	case desc.Enum() != nil:
+		ident.GoName = "int32"
+		if p.enumAsString(member) {
+			ident.GoName = "string"
+		}
+	case desc.Message() == nil:
+		ident.GoName = protoPrimitiveKinds[desc.Kind()]
+	case desc.Message().FullName() == "google.protobuf.Timestamp":
+		return ptrIdent(identTime), true
+	default:
+		v, exists := wellKnownTypes[string(desc.Message().Name())]
+		if !exists || desc.Message().ParentFile().Package() != "google.protobuf" {
+			return ident, false
+		}
+		return protogen.GoIdent{GoName: v}, true
+	}
+	if isOptionalScalar(member) && ident.GoName != "[]byte" {
+		ident.GoName = "*" + ident.GoName
+	}
+	return ident, true
+}
+
+// parseEmbedded adds one ORM field per member of the embedded message, the
+// member columns are prefixed with the column prefix of the field
+func (p *OrmPlugin) parseEmbedded(ormable *OrmableType, field *protogen.Field) {
+	prefix := embeddedColumnPrefix(field)
+	for _, member := range field.Message.Fields {
+		if getFieldOptions(member).GetDrop() {
+			continue
+		}
+		ident, ok := p.embeddedMemberIdent(member)
+		if !ok {
+			p.warning("member %s of embedded %s cannot be stored in its own column", member.GoName, field.GoName)
+			continue
+		}
+		tag := &gorm.GormTag{}
+		if memberTag := getFieldOptions(member).GetTag(); memberTag != nil {
+			tag = proto.Clone(memberTag).(*gorm.GormTag)
+		}
+		column := tag.GetColumn()
+		if column == "" {
+			column = naming.ColumnName("", member.GoName)
+		}
+		tag.Column = proto.String(prefix + column)
+		if member.Enum != nil {
+			tag = p.nativeEnumTag(ormable, member, tag)
+		}
+		name := embeddedFieldName(field, member)
+		if _, exists := ormable.Fields[name]; exists {
+			p.Fail("Cannot flatten", member.GoName, "of", field.GoName, "into", ormable.Name, "as", name, "already exists there.")
+		}
+		ormable.Fields[name] = &Field{Type: ident.GoName, F: &protogen.Field{GoIdent: ident},
+			GormFieldOptions: &gorm.GormFieldOptions{Tag: tag}}
+	}
+}
+
+// generateEmbeddedConversion outputs the member by member conversion of an
+// embedded message to/from its flattened ORM fields, the message is only set
+// in PB format if any of its members is
+func (p *OrmPlugin) generateEmbeddedConversion(field *protogen.Field, toORM bool) {
+	fieldName := fieldName(field)
+	if toORM {
+		p.P(`if m.`, fieldName, ` != nil {`)
+	} else {
+		p.P(`to.`, fieldName, ` = &`, field.Message.GoIdent, `{}`)
+	}
+	for _, member := range p.embeddedMembers(field) {
+		ormName := embeddedFieldName(field, member)
+		if toORM {
+			p.generateEmbeddedMemberConversion(member, "to."+ormName, "m."+fieldName+"."+member.GoName, true)
+		} else {
+			p.generateEmbeddedMemberConversion(member, "to."+fieldName+"."+member.GoName, "m."+ormName, false)
+		}
+	}
+	if toORM {
+		p.P(`}`)
+	} else {
+		p.P(`if `, identProtoSizeFn, `(to.`, fieldName, `) == 0 {`)
+		p.P(`to.`, fieldName, ` = nil`)
+		p.P(`}`)
+	}
+}
+
+func (p *OrmPlugin) generateEmbeddedMemberConversion(member *protogen.Field, to, from string, toORM bool) {
+	desc := member.Desc
+	switch {
+	case desc.Enum() != nil:
+		value, temp := from, "temp"+strings.ReplaceAll(from[len("m."):], ".", "")
+		if isOptionalScalar(member) {
+			p.P(`if `, from, ` != nil {`)
+			value, temp = "*"+from, "value"
+		}
+		var conv []interface{}
+		if toORM {
+			conv = p.enumToORM(member, value)
+		} else {
+			conv = p.enumToPB(member, value, temp)
+		}
+		if isOptionalScalar(member) {
+			p.P(append([]interface{}{`v := `}, conv...)...)
+			p.P(to, ` = &v`)
+			p.P(`}`)
+		} else {
+			p.P(append([]interface{}{to, ` = `}, conv...)...)
+		}
+	case desc.Message() == nil:
+		if isOptionalScalar(member) && desc.Kind() != protoreflect.BytesKind {
+			p.P(`if `, from, ` != nil {`)
+			p.P(`v := *`, from)
+			p.P(to, ` = &v`)
+			p.P(`}`)
+		} else {
+			p.P(to, ` = `, from)
+		}
+	case desc.Message().FullName() == "google.protobuf.Timestamp":
+		p.P(`if `, from, ` != nil {`)
+		if toORM {
+			p.P(`if !`, from, `.IsValid() {`)
+			p.P(`return to, `, identFmtErrorf, `("`, from[len("m."):], ` invalid")`)
+			p.P(`}`)
+			p.P(`t := `, from, `.AsTime()`)
+			p.P(to, ` = &t`)
+		} else {
+			p.P(to, ` = `, identTimestampNewFn, `(*`, from, `)`)
+		}
+		p.P(`}`)
+	case isBytesValue(member):
+		p.P(`if `, from, ` != nil {`)
+		if toORM {
+			p.P(to, ` = append([]byte{}, `, from, `.Value...)`)
+		} else {
+			p.P(to, ` = &`, member.Message.GoIdent, `{Value: `, from, `}`)
+		}
+		p.P(`}`)
+	default: // wrapper types
+		p.P(`if `, from, ` != nil {`)
+		if toORM {
+			p.P(`v := `, from, `.Value`)
+			p.P(to, ` = &v`)
+		} else {
+			p.P(to, ` = &`, member.Message.GoIdent, `{Value: *`, from, `}`)
+		}
+		p.P(`}`)
+	}
+}
+
+// generateEmbeddedApplyFieldMask outputs the field mask handling of an
+// embedded message, the whole message is replaced by its own path and single
+// members are set by a path suffixed with the member name
+func (p *OrmPlugin) generateEmbeddedApplyFieldMask(field *protogen.Field) {
+	ccName := fieldName(field)
+	p.P(`if f == prefix+"`, ccName, `" {`)
+	p.P(`patchee.`, ccName, ` = patcher.`, ccName)
+	p.P(`continue`)
+	p.P(`}`)
+	p.P(`if `, identStringsHasPrefixFn, `(f, prefix+"`, ccName, `.") {`)
+	p.P(`if patchee.`, ccName, ` == nil {`)
+	p.P(`patchee.`, ccName, ` = &`, field.Message.GoIdent, `{}`)
+	p.P(`}`)
+	p.P(`source := patcher.`, ccName)
+	p.P(`if source == nil {`)
+	p.P(`source = &`, field.Message.GoIdent, `{}`)
+	p.P(`}`)
+	for _, member := range p.embeddedMembers(field) {
+		p.P(`if f == prefix+"`, ccName, `.`, member.GoName, `" {`)
+		p.P(`patchee.`, ccName, `.`, member.GoName, ` = source.`, member.GoName)
+		p.P(`}`)
+	}
+	p.P(`continue`)
+	p.P(`}`)
+}
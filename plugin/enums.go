@@ -0,0 +1,110 @@
+package plugin
+
+import (
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// enumStorage is how the values of an enum field are stored, the field option
+// wins over the file option, then the enums plugin parameter applies
+func (p *OrmPlugin) enumStorage(field *protogen.Field) gorm.EnumStorage {
+	if storage := getFieldOptions(field).GetEnumStorage(); storage != gorm.EnumStorage_ENUM_STORAGE_DEFAULT {
+		return storage
+	}
+	if storage := getFileOptions(field.Desc.ParentFile()).GetEnumStorage(); storage != gorm.EnumStorage_ENUM_STORAGE_DEFAULT {
+		return storage
+	}
+	if p.StringEnums {
+		return gorm.EnumStorage_ENUM_STORAGE_STRING
+	}
+	return gorm.EnumStorage_ENUM_STORAGE_INT
+}
+
+// enumAsString tells if the enum field is stored by value name
+func (p *OrmPlugin) enumAsString(field *protogen.Field) bool {
+	return p.enumStorage(field) != gorm.EnumStorage_ENUM_STORAGE_INT
+}
+
+// enumTypeName is the name of the native database enum type of an enum
+func enumTypeName(enum *protogen.Enum) string {
+	return naming.ColumnName("", enum.GoIdent.GoName)
+}
+
+// nativeEnumTag sets the column type of an enum field stored in a native
+// database enum type, whose definition is generated along with the ormable.
+// MySQL declares the enum inline in the column type and SQLite has no enum
+// types, the value names are stored as text there
+func (p *OrmPlugin) nativeEnumTag(ormable *OrmableType, field *protogen.Field, tag *gorm.GormTag) *gorm.GormTag {
+	if p.enumStorage(field) != gorm.EnumStorage_ENUM_STORAGE_NATIVE {
+		return tag
+	}
+	switch p.Dialect {
+	case dialectMySQL:
+		return tagWithType(tag, mysqlEnumType(field.Enum))
+	case dialectSQLite:
+		return tag
+	}
+	p.addNativeEnum(ormable.File, field.Enum)
+	typeName := nativeEnumTypeName(ormable.File, field.Enum)
+	if field.Desc.IsList() {
+		typeName += "[]"
+	}
+	return tagWithType(tag, typeName)
+}
+
+// nativeEnumTypeName is the name of the database enum type of an enum stored
+// by the ormable messages of the file, qualified by the schema of the file
+func nativeEnumTypeName(file *protogen.File, enum *protogen.Enum) string {
+	return qualifiedTableName(getFileOptions(file.Desc), enumTypeName(enum))
+}
+
+// addNativeEnum records the enum type definition to generate in the file, a
+// definition is generated once per Go package
+func (p *OrmPlugin) addNativeEnum(file *protogen.File, enum *protogen.Enum) {
+	key := string(file.GoImportPath) + "." + enum.GoIdent.GoName
+	typeName := nativeEnumTypeName(file, enum)
+	if defined, ok := p.nativeEnumKeys[key]; ok {
+		if defined != typeName {
+			p.Fail("Enum", enum.GoIdent.GoName, "is stored as", defined, "and", typeName,
+				"in package", string(file.GoImportPath), "which defines one enum type per Go package.")
+		}
+		return
+	}
+	p.nativeEnumKeys[key] = typeName
+	p.nativeEnums[file] = append(p.nativeEnums[file], enum)
+}
+
+// generateNativeEnumTypes outputs the definitions of the database enum types
+// the ormable messages of the file store their enum fields in
+func (p *OrmPlugin) generateNativeEnumTypes(file *protogen.File) {
+	for _, enum := range p.nativeEnums[file] {
+		name := enum.GoIdent.GoName + "EnumType"
+		typeName := nativeEnumTypeName(file, enum)
+		p.P(`// `, name, ` creates the `, typeName, ` database enum type of `, enum.GoIdent.GoName)
+		p.P(`const `, name, " = `CREATE TYPE ", typeName, ` AS ENUM (`, enumValueList(enum, ", "), ")`")
+		p.P()
+	}
+}
+
+// enumToPB outputs the lookup of the enum value named by source when the
+// field is stored by name, an unknown name makes ToPB fail, and returns the
+// expression of the enum value
+func (p *OrmPlugin) enumToPB(field *protogen.Field, source, temp string) []interface{} {
+	ident := field.Enum.GoIdent
+	if !p.enumAsString(field) {
+		return []interface{}{ident, `(`, source, `)`}
+	}
+	p.P(temp, `, ok := `, ident, `_value[`, source, `]`)
+	p.P(`if !ok && `, source, ` != "" {`)
+	p.P(`return to, `, identFmtErrorf, `("unknown `, ident.GoName, ` value %q", `, source, `)`)
+	p.P(`}`)
+	return []interface{}{ident, `(`, temp, `)`}
+}
+
+// enumToORM returns the expression of the value stored for the enum in source
+func (p *OrmPlugin) enumToORM(field *protogen.Field, source string) []interface{} {
+	if p.enumAsString(field) {
+		return []interface{}{field.Enum.GoIdent, `_name[int32(`, source, `)]`}
+	}
+	return []interface{}{`int32(`, source, `)`}
+}
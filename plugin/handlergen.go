@@ -2,25 +2,25 @@ package plugin
 
 import (
 	"fmt"
-	jgorm "github.com/jinzhu/gorm"
 	"google.golang.org/protobuf/compiler/protogen"
 	"strings"
 )
 
 func (p *OrmPlugin) generateDefaultHandlers(file *protogen.File) {
 	if p.DefaultHandlers {
-		for _, message := range file.Messages {
+		for _, message := range allMessages(file.Messages) {
 			if getMessageOptions(message).GetOrmable() {
 				p.generateCreateHandler(message)
-				// FIXME: Temporary fix for Ormable objects that have no ID field but
-				// have pk.
-				if p.hasPrimaryKey(p.getOrmable(message.GoIdent.GoName)) && p.hasIDField(message) {
+				// objects are identified by their primary keys, these must be
+				// fields of the message
+				if p.hasDeclaredPrimaryKey(message) {
 					p.generateReadHandler(message)
 					p.generateDeleteHandler(message)
 					p.generateDeleteSetHandler(message)
 					p.generateStrictUpdateHandler(message)
 					p.generatePatchHandler(message)
 					p.generatePatchSetHandler(message)
+					p.generateSoftDeleteHandlers(message)
 				}
 
 				p.generateApplyFieldMask(message)
@@ -104,7 +104,7 @@ func (p *OrmPlugin) generateCreateHandler(message *protogen.Message) {
 	p.P(`}`)
 	create := "Create_"
 	p.generateBeforeHookCall(orm, create)
-	p.P(`if err = db.Create(&ormObj).Error; err != nil {`)
+	p.P(`if err = db`, p.omitAssociations(orm, true), `.Create(&ormObj).Error; err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
 	p.generateAfterHookCall(orm, create)
@@ -136,14 +136,7 @@ func (p *OrmPlugin) generateReadHandler(message *protogen.Message) {
 	p.P(`if err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
-	k, f := p.findPrimaryKey(ormable)
-	if strings.Contains(f.F.GoIdent.GoName, "*") {
-		p.P(`if ormObj.`, k, ` == nil || *ormObj.`, k, ` == `, p.guessZeroValue(f.F.GoIdent.GoName), ` {`)
-	} else {
-		p.P(`if ormObj.`, k, ` == `, p.guessZeroValue(f.F.GoIdent.GoName), ` {`)
-	}
-	p.P(`return nil, `, identEmptyIDError)
-	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, "ormObj", "nil, ")
 
 	var fs string
 	if p.readHasFieldSelection(ormable) {
@@ -159,7 +152,7 @@ func (p *OrmPlugin) generateReadHandler(message *protogen.Message) {
 
 	p.generateBeforeReadHookCall(ormable, "Find")
 	p.P(`ormResponse := `, ormable.Name, `{}`)
-	p.P(`if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {`)
+	p.P(`if err = db.Where(`, p.rowCondition(ormable, "ormObj"), `).First(&ormResponse).Error; err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
 	p.generateAfterReadHookCall(ormable)
@@ -240,10 +233,17 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 		fieldName := fieldName(field)
 		notSpecialType := !p.isSpecialType(field)
 
-		if desc.Message() != nil && notSpecialType && !desc.IsList() {
+		if p.isEmbeddedMessage(field) || isImmutableField(field) {
+			continue
+		} else if isOneofField(field) {
+			if p.isOneofNestedPatch(field) {
+				p.P(`var updated`, fieldName, ` bool`)
+				hasNested = true
+			}
+		} else if desc.Message() != nil && notSpecialType && !desc.IsList() && !desc.IsMap() {
 			p.P(`var updated`, fieldName, ` bool`)
 			hasNested = true
-		} else if strings.HasSuffix(fieldType, protoTypeJSON) {
+		} else if (strings.HasSuffix(fieldType, protoTypeJSON) || isStructMessage(desc.Message())) && !desc.IsList() {
 			p.P(`var updated`, fieldName, ` bool`)
 		}
 	}
@@ -257,6 +257,30 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 		desc := field.Desc
 		ccName := fieldName(field)
 		fieldType := p.fieldType(field)
+		if isOneofField(field) {
+			if isFirstOneofField(field) {
+				p.generateOneofApplyFieldMask(field.Oneof)
+			}
+			continue
+		}
+		if desc.IsMap() {
+			p.generateMapApplyFieldMask(field)
+			continue
+		}
+		if p.isEmbeddedMessage(field) {
+			p.generateEmbeddedApplyFieldMask(field)
+			continue
+		}
+		if isImmutableField(field) {
+			if desc.Message() != nil {
+				p.P(`if f == prefix+"`, ccName, `" || `, identStringsHasPrefixFn, `(f, prefix+"`, ccName, `.") {`)
+			} else {
+				p.P(`if f == prefix+"`, ccName, `" {`)
+			}
+			p.P(`return nil, `, identFmtErrorf, `(`, identImmutableFieldMaskTplError, `, f)`)
+			p.P(`}`)
+			continue
+		}
 		//  for ormable message, do recursive patching
 		if desc.Message() != nil && p.isOrmable(fieldType) && !desc.IsList() {
 			ident := p.qualifiedGoIdent(fieldIdent(field))
@@ -314,7 +338,7 @@ func (p *OrmPlugin) generateApplyFieldMask(message *protogen.Message) {
 			p.P(`patchee.`, ccName, ` = patcher.`, ccName)
 			p.P(`continue`)
 			p.P(`}`)
-		} else if strings.HasSuffix(fieldType, protoTypeJSON) && !desc.IsList() {
+		} else if (strings.HasSuffix(fieldType, protoTypeJSON) || isStructMessage(desc.Message())) && !desc.IsList() {
 			p.P(`if !updated`, ccName, ` && `, identStringsHasPrefixFn, `(f, prefix+"`, ccName, `") {`)
 			p.P(`patchee.`, ccName, ` = patcher.`, ccName)
 			p.P(`updated`, ccName, ` = true`)
@@ -345,6 +369,65 @@ func (p *OrmPlugin) hasIDField(message *protogen.Message) bool {
 	return false
 }
 
+// hasDeclaredPrimaryKey tells if the ormable has primary keys which are all
+// fields of the message
+func (p *OrmPlugin) hasDeclaredPrimaryKey(message *protogen.Message) bool {
+	pkNames := p.primaryKeyNames(p.getOrmableMessage(message))
+	if len(pkNames) == 0 {
+		return false
+	}
+	declared := map[string]bool{}
+	for _, field := range message.Fields {
+		declared[fieldName(field)] = true
+	}
+	for _, pkName := range pkNames {
+		if !declared[pkName] {
+			return false
+		}
+	}
+	return true
+}
+
+// primaryKeyLiteral is the composite literal fields copying the primary keys
+// of the message named object, e.g. TenantId: in.TenantId, Name: in.Name
+func (p *OrmPlugin) primaryKeyLiteral(ormable *OrmableType, object string) string {
+	pkNames := p.primaryKeyNames(ormable)
+	fields := make([]string, len(pkNames))
+	for i, pkName := range pkNames {
+		fields[i] = pkName + `: ` + object + `.` + pkName
+	}
+	return strings.Join(fields, `, `)
+}
+
+// rowCondition is the struct condition of the row of the ORM object named
+// object, its primary keys and account, the other fields may hold values
+// stored for zero values like the names of zero enums
+func (p *OrmPlugin) rowCondition(ormable *OrmableType, object string) string {
+	fields := []string{p.primaryKeyLiteral(ormable, object)}
+	if getMessageOptions(ormable.Message).GetMultiAccount() {
+		fields = append(fields, `AccountID: `+object+`.AccountID`)
+	}
+	return `&` + ormable.Name + `{` + strings.Join(fields, `, `) + `}`
+}
+
+// generateEmptyPrimaryKeyCheck outputs the check returning EmptyIdError when
+// any primary key of the ORM object is not set
+func (p *OrmPlugin) generateEmptyPrimaryKeyCheck(ormable *OrmableType, object, returnValues string) {
+	pkNames, pks := p.findPrimaryKeys(ormable)
+	conditions := make([]string, len(pkNames))
+	for i, pkName := range pkNames {
+		pkType := pks[i].F.GoIdent.GoName
+		if strings.Contains(pkType, "*") {
+			conditions[i] = object + `.` + pkName + ` == nil || *` + object + `.` + pkName + ` == ` + p.guessZeroValue(pkType)
+		} else {
+			conditions[i] = object + `.` + pkName + ` == ` + p.guessZeroValue(pkType)
+		}
+	}
+	p.P(`if `, strings.Join(conditions, ` || `), ` {`)
+	p.P(`return `, returnValues, identEmptyIDError)
+	p.P(`}`)
+}
+
 func (p *OrmPlugin) generatePatchHandler(message *protogen.Message) {
 	var isMultiAccount bool
 
@@ -371,9 +454,9 @@ func (p *OrmPlugin) generatePatchHandler(message *protogen.Message) {
 	p.P(`var err error`)
 	p.generateBeforePatchHookCall(ormable, "Read")
 	if p.readHasFieldSelection(ormable) {
-		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, nil)`)
+		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(ormable, "in"), `}, db, nil)`)
 	} else {
-		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+		p.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{`, p.primaryKeyLiteral(ormable, "in"), `}, db)`)
 	}
 
 	p.P(`if err != nil {`)
@@ -386,6 +469,10 @@ func (p *OrmPlugin) generatePatchHandler(message *protogen.Message) {
 	p.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
+	if ormable.Version != "" {
+		// the update is guarded by the version the client read, not the current one
+		p.P(`pbObj.`, ormable.Version, ` = in.`, ormable.Version)
+	}
 
 	p.generateBeforePatchHookCall(ormable, "Save")
 	p.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, &pbObj, db)`)
@@ -480,16 +567,9 @@ func (p *OrmPlugin) generateDeleteHandler(message *protogen.Message) {
 	p.P(`return err`)
 	p.P(`}`)
 	ormable := p.getOrmable(typeName)
-	pkName, pk := p.findPrimaryKey(ormable)
-	if strings.Contains(pk.Type, "*") {
-		p.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), ` {`)
-	} else {
-		p.P(`if ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), `{`)
-	}
-	p.P(`return `, identEmptyIDError)
-	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, "ormObj", "")
 	p.generateBeforeDeleteHookCall(ormable)
-	p.P(`err = db.Where(&ormObj).Delete(&`, ormable.Name, `{}).Error`)
+	p.P(`err = db.Where(`, p.rowCondition(ormable, "ormObj"), `).Delete(&`, ormable.Name, `{}).Error`)
 	p.P(`if err != nil {`)
 	p.P(`return err`)
 	p.P(`}`)
@@ -524,35 +604,58 @@ func (p *OrmPlugin) generateDeleteSetHandler(message *protogen.Message) {
 	p.P(`}`)
 	p.P(`var err error`)
 	ormable := p.getOrmable(typeName)
-	pkName, pk := p.findPrimaryKey(ormable)
-	column := pk.GetTag().GetColumn()
-	if len(column) != 0 {
-		pkName = column
+	pkNames, pks := p.findPrimaryKeys(ormable)
+	columns := make([]string, len(pkNames))
+	values := make([]string, len(pkNames))
+	for i, pkName := range pkNames {
+		columns[i] = columnName(pkName, pks[i])
+		values[i] = `ormObj.` + pkName
+	}
+	// composite keys are matched as tuples, (a, b) IN ((?, ?), ...)
+	condition := columns[0] + ` in (?)`
+	if len(pkNames) == 1 {
+		p.P(`keys := []`, p.qualifiedGoIdent(pks[0].F.GoIdent), `{}`)
+	} else {
+		condition = `(` + strings.Join(columns, `, `) + `) IN ?`
+		p.P(`keys := [][]interface{}{}`)
 	}
-	p.P(`keys := []`, p.qualifiedGoIdent(pk.F.GoIdent), `{}`)
 	p.P(`for _, obj := range in {`)
 	p.P(`ormObj, err := obj.ToORM(ctx)`)
 	p.P(`if err != nil {`)
 	p.P(`return err`)
 	p.P(`}`)
-	if strings.Contains(pk.Type, "*") {
-		p.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), ` {`)
+	p.generateEmptyPrimaryKeyCheck(ormable, "ormObj", "")
+	if len(pkNames) == 1 {
+		p.P(`keys = append(keys, `, values[0], `)`)
 	} else {
-		p.P(`if ormObj.`, pkName, ` == `, p.guessZeroValue(pk.Type), `{`)
+		p.P(`keys = append(keys, []interface{}{`, strings.Join(values, `, `), `})`)
 	}
-	p.P(`return `, identEmptyIDError)
-	p.P(`}`)
-	p.P(`keys = append(keys, ormObj.`, pkName, `)`)
 	p.P(`}`)
 	p.generateBeforeDeleteSetHookCall(ormable)
+	keysArg := `keys`
+	if len(pkNames) > 1 && p.Dialect == dialectSQLite {
+		// SQLite has no lists of row values, the keys are matched one by one,
+		// starting from a false condition so that no keys match no rows
+		p.P(`keysWhere := db.Session(&`, identGormSession, `{NewDB: true}).Where("1 = 0")`)
+		p.P(`for _, key := range keys {`)
+		p.P(`keysWhere = keysWhere.Or("`, strings.Join(columns, ` = ? AND `), ` = ?", key...)`)
+		p.P(`}`)
+		keysArg = `keysWhere`
+	}
 	if getMessageOptions(message).GetMultiAccount() {
 		p.P(`acctId, err := `, identGetAccountIDFn, `(ctx, nil)`)
 		p.P(`if err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
-		p.P(`err = db.Where("account_id = ? AND `, jgorm.ToDBName(pkName), ` in (?)", acctId, keys).Delete(&`, ormable.Name, `{}).Error`)
+		if keysArg == `keysWhere` {
+			p.P(`err = db.Where("account_id = ?", acctId).Where(keysWhere).Delete(&`, ormable.Name, `{}).Error`)
+		} else {
+			p.P(`err = db.Where("account_id = ? AND `, condition, `", acctId, keys).Delete(&`, ormable.Name, `{}).Error`)
+		}
+	} else if keysArg == `keysWhere` {
+		p.P(`err = db.Where(keysWhere).Delete(&`, ormable.Name, `{}).Error`)
 	} else {
-		p.P(`err = db.Where("`, jgorm.ToDBName(pkName), ` in (?)", keys).Delete(&`, ormable.Name, `{}).Error`)
+		p.P(`err = db.Where("`, condition, `", keys).Delete(&`, ormable.Name, `{}).Error`)
 	}
 	p.P(`if err != nil {`)
 	p.P(`return err`)
@@ -626,16 +729,19 @@ func (p *OrmPlugin) generateListHandler(message *protogen.Message) {
 	p.P(`return nil, err`)
 	p.P(`}`)
 	p.generateBeforeListHookCall(ormable, "Find", true)
-	p.P(`db = db.Where(&ormObj)`)
+	p.generateDeletedFilter(message)
+	if getMessageOptions(message).GetMultiAccount() {
+		p.P(`db = db.Where(&`, ormable.Name, `{AccountID: ormObj.AccountID})`)
+	}
 
 	// add default ordering by primary key
 	if p.hasPrimaryKey(ormable) {
-		pkName, pk := p.findPrimaryKey(ormable)
-		column := pk.GetTag().GetColumn()
-		if len(column) == 0 {
-			column = jgorm.ToDBName(pkName)
+		pkNames, pks := p.findPrimaryKeys(ormable)
+		columns := make([]string, len(pkNames))
+		for i, pkName := range pkNames {
+			columns[i] = columnName(pkName, pks[i])
 		}
-		p.P(`db = db.Order("`, column, `")`)
+		p.P(`db = db.Order("`, strings.Join(columns, ", "), `")`)
 	}
 
 	p.P(`ormResponse := []`, ormable.Name, `{}`)
@@ -749,30 +855,45 @@ func (p *OrmPlugin) generateStrictUpdateHandler(message *protogen.Message) {
 		p.generateAccountIdWhereClause()
 	}
 	ormable := p.getOrmable(typeName)
-	if p.Gateway {
+	if p.Gateway || ormable.Version != "" || hasPermissions(ormable) {
 		p.P(`var count int64`)
 	}
 	if p.hasPrimaryKey(ormable) {
-		pkName, pk := p.findPrimaryKey(ormable)
-		column := pk.GetTag().GetColumn()
-		if len(column) == 0 {
-			column = jgorm.ToDBName(pkName)
+		pkNames, pks := p.findPrimaryKeys(ormable)
+		conditions := make([]string, len(pkNames))
+		values := make([]string, len(pkNames))
+		for i, pkName := range pkNames {
+			conditions[i] = columnName(pkName, pks[i]) + `=?`
+			values[i] = `ormObj.` + pkName
 		}
 		p.P(`lockedRow := &`, typeName, `ORM{}`)
 		var count string
 		var rowsAffected string
-		if p.Gateway {
+		if p.Gateway || ormable.Version != "" || hasPermissions(ormable) {
 			count = `count = `
 			rowsAffected = `.RowsAffected`
 		}
-		p.P(count+`db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("`, column, `=?", ormObj.`, pkName, `).First(lockedRow)`+rowsAffected)
+		lockedQuery := append([]interface{}{count + `db.Model(&ormObj)`}, p.lockingClause()...)
+		p.P(append(lockedQuery, `.Where("`, strings.Join(conditions, ` AND `), `", `, strings.Join(values, `, `), `).First(lockedRow)`+rowsAffected)...)
+		// the creation time is kept, GORM sets it again when the row is missing
+		for _, fieldName := range p.createTimeFields(ormable) {
+			p.P(`ormObj.`, fieldName, ` = lockedRow.`, fieldName)
+		}
+		p.generateKeptFields(ormable)
+	}
+	if ormable.Version != "" {
+		p.generateVersionCheck(ormable)
 	}
 	p.generateBeforeHookCall(ormable, "StrictUpdateCleanup")
 	p.handleChildAssociations(message)
 	p.generateBeforeHookCall(ormable, "StrictUpdateSave")
-	p.P(`if err = db.Save(&ormObj).Error; err != nil {`)
-	p.P(`return nil, err`)
-	p.P(`}`)
+	if ormable.Version != "" {
+		p.generateVersionedSave(ormable)
+	} else {
+		p.P(`if err = db`, p.omitAssociations(ormable, false), `.Save(&ormObj).Error; err != nil {`)
+		p.P(`return nil, err`)
+		p.P(`}`)
+	}
 	p.generateAfterHookCall(ormable, "StrictUpdateSave")
 	p.P(`pbResponse, err := ormObj.ToPB(ctx)`)
 	p.P(`if err != nil {`)
@@ -870,39 +991,41 @@ func (p *OrmPlugin) removeChildAssociationsByName(message *protogen.Message, fie
 	}
 
 	if field.GetHasMany() != nil || field.GetHasOne() != nil {
-		var assocKeyName, foreignKeyName string
+		var assocKeyNames, foreignKeyNames []string
 		switch {
 		case field.GetHasMany() != nil:
-			assocKeyName = field.GetHasMany().GetAssociationForeignkey()
-			foreignKeyName = field.GetHasMany().GetForeignkey()
+			assocKeyNames = splitKeyNames(field.GetHasMany().GetAssociationForeignkey())
+			foreignKeyNames = splitKeyNames(field.GetHasMany().GetForeignkey())
 		case field.GetHasOne() != nil:
-			assocKeyName = field.GetHasOne().GetAssociationForeignkey()
-			foreignKeyName = field.GetHasOne().GetForeignkey()
+			assocKeyNames = splitKeyNames(field.GetHasOne().GetAssociationForeignkey())
+			foreignKeyNames = splitKeyNames(field.GetHasOne().GetForeignkey())
 		}
-		assocKeyType := ormable.Fields[assocKeyName].Type
 		assocOrmable := p.getOrmable(field.Type)
-		foreignKeyType := p.qualifiedGoIdent(assocOrmable.Fields[foreignKeyName].F.GoIdent)
 
-		// foreignKeyF := assocOrmable.Fields[foreignKeyName].F
 		p.P(`filter`, fieldName, ` := `, strings.Trim(field.Type, "[]*"), `{}`)
-		zeroValue := p.guessZeroValue(assocKeyType)
-		if strings.Contains(assocKeyType, "*") {
-			p.P(`if ormObj.`, assocKeyName, ` == nil || *ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
-		} else {
-			p.P(`if ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
-		}
-		p.P(`return nil, `, identEmptyIDError)
-		p.P(`}`)
-		filterDesc := "filter" + fieldName + "." + foreignKeyName
-		ormDesc := "ormObj." + assocKeyName
-		if strings.HasPrefix(foreignKeyType, "*") {
-			p.P(filterDesc, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
-			filterDesc = "*" + filterDesc
-		}
-		if strings.HasPrefix(assocKeyType, "*") {
-			ormDesc = "*" + ormDesc
+		for i, assocKeyName := range assocKeyNames {
+			foreignKeyName := foreignKeyNames[i]
+			assocKeyType := ormable.Fields[assocKeyName].Type
+			foreignKeyType := p.qualifiedGoIdent(assocOrmable.Fields[foreignKeyName].F.GoIdent)
+			zeroValue := p.guessZeroValue(assocKeyType)
+			if strings.Contains(assocKeyType, "*") {
+				p.P(`if ormObj.`, assocKeyName, ` == nil || *ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+			} else {
+				p.P(`if ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+			}
+			p.P(`return nil, `, identEmptyIDError)
+			p.P(`}`)
+			filterDesc := "filter" + fieldName + "." + foreignKeyName
+			ormDesc := "ormObj." + assocKeyName
+			if strings.HasPrefix(foreignKeyType, "*") {
+				p.P(filterDesc, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
+				filterDesc = "*" + filterDesc
+			}
+			if strings.HasPrefix(assocKeyType, "*") {
+				ormDesc = "*" + ormDesc
+			}
+			p.P(filterDesc, " = ", ormDesc)
 		}
-		p.P(filterDesc, " = ", ormDesc)
 		p.P(`if err = db.Where(filter`, fieldName, `).Delete(`, strings.Trim(field.Type, "[]*"), `{}).Error; err != nil {`)
 		p.P(`return nil, err`)
 		p.P(`}`)
@@ -920,7 +1043,8 @@ func (p *OrmPlugin) guessZeroValue(typeName string) string {
 	for _, current := range []tmp{
 		{cmp: "string", ret: `""`},
 		{cmp: "int", ret: `0`},
-		{cmp: "uuid", ret: identNilUUID},
+		{cmp: "float", ret: `0`},
+		{cmp: "uuid", ret: p.uuid.Nil},
 		{cmp: "[]byte", ret: `nil`},
 		{cmp: "bool", ret: `false`},
 	} {
@@ -0,0 +1,139 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kirinse/atlas-app-toolkit/util/cases"
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// indexTypes are the index methods of each dialect
+var indexTypes = map[string][]string{
+	dialectPostgres: {"btree", "hash", "gist", "spgist", "gin", "brin"},
+	dialectMySQL:    {"btree", "hash"},
+}
+
+// parseIndexes adds the message level indexes to the fields they reference,
+// each column of an index is a GORM index tag with the priority of its position
+func (p *OrmPlugin) parseIndexes(message *protogen.Message) {
+	ormable := p.getOrmableMessage(message)
+	// like GORM the index names have underscores for the dots of the table
+	table := strings.ReplaceAll(tableName(message), ".", "_")
+	for n, index := range getMessageOptions(message).GetIndexes() {
+		// unnamed indexes are reported by position until their name is known
+		label := index.GetName()
+		if label == "" {
+			label = fmt.Sprint("#", n+1)
+		}
+		if len(index.GetColumns()) == 0 {
+			p.Fail("index", label, "of", ormable.OriginName, "has no columns")
+		}
+		fields := make([]*Field, len(index.GetColumns()))
+		columns := make([]string, len(index.GetColumns()))
+		for i, column := range index.GetColumns() {
+			name, field := p.findColumnField(ormable, column.GetField())
+			if field == nil {
+				p.Fail("index", label, "of", ormable.OriginName, "references unknown field", column.GetField())
+			}
+			for _, other := range fields[:i] {
+				if other == field {
+					p.Fail("index", label, "of", ormable.OriginName, "references field", column.GetField(), "twice")
+				}
+			}
+			fields[i], columns[i] = field, columnName(name, field)
+		}
+		name := index.GetName()
+		if name == "" {
+			name = "idx_" + table + "_" + strings.Join(columns, "_")
+		}
+		settings := p.indexSettings(ormable, name, index)
+		for i, column := range index.GetColumns() {
+			fields[i].Indexes = append(fields[i].Indexes, p.indexColumnSettings(ormable, name, i, column, settings))
+		}
+	}
+}
+
+// findColumnField looks up the ORM field of a column by its proto, Go
+// or included name, associations and embedded messages have no column
+func (p *OrmPlugin) findColumnField(ormable *OrmableType, name string) (string, *Field) {
+	fieldName := name
+	field, ok := ormable.Fields[fieldName]
+	if !ok {
+		fieldName = cases.GoCamelCase(name)
+		field, ok = ormable.Fields[fieldName]
+	}
+	if !ok || field.GetAssociation() != nil || field.GetTag().GetEmbedded() || field.GetTag().GetIgnore() {
+		return "", nil
+	}
+	return fieldName, field
+}
+
+// indexSettings are the settings of the whole index, GORM merges them from
+// the tags of all its columns so they are only set on the first one
+func (p *OrmPlugin) indexSettings(ormable *OrmableType, name string, index *gorm.GormIndex) string {
+	var settings []string
+	if index.GetUnique() {
+		settings = append(settings, "unique")
+	}
+	if indexType := strings.ToLower(index.GetType()); indexType != "" {
+		if !containsString(indexTypes[p.Dialect], indexType) {
+			p.Fail("index", name, "of", ormable.OriginName, "has type", index.GetType(), "which is not supported on", p.Dialect)
+		}
+		settings = append(settings, "type:"+indexType)
+	}
+	if where := index.GetWhere(); where != "" {
+		if p.Dialect == dialectMySQL {
+			p.Fail("index", name, "of", ormable.OriginName, "is partial, mysql has no partial indexes")
+		}
+		settings = append(settings, "where:"+p.indexTagValue(ormable, name, where))
+	}
+	if comment := index.GetComment(); comment != "" {
+		settings = append(settings, "comment:"+p.indexTagValue(ormable, name, comment))
+	}
+	return strings.Join(settings, ",")
+}
+
+// indexColumnSettings is the index tag of the column at position i
+func (p *OrmPlugin) indexColumnSettings(ormable *OrmableType, name string, i int, column *gorm.GormIndexColumn, settings string) string {
+	res := []string{name, fmt.Sprintf("priority:%d", i+1)}
+	switch sort := strings.ToLower(column.GetSort()); sort {
+	case "":
+	case "asc", "desc":
+		res = append(res, "sort:"+sort)
+	default:
+		p.Fail("index", name, "of", ormable.OriginName, "sorts", column.GetField(), "by", column.GetSort(), "expected asc or desc")
+	}
+	if expression := column.GetExpression(); expression != "" {
+		res = append(res, "expression:"+p.indexTagValue(ormable, name, expression))
+	}
+	if collate := column.GetCollate(); collate != "" {
+		res = append(res, "collate:"+p.indexTagValue(ormable, name, collate))
+	}
+	if column.Length != nil {
+		res = append(res, fmt.Sprintf("length:%d", column.GetLength()))
+	}
+	if i == 0 && settings != "" {
+		res = append(res, settings)
+	}
+	return strings.Join(res, ",")
+}
+
+// indexTagValue escapes a value of the index tag, commas are escaped for
+// GORM, backslashes and quotes for the struct tag, semicolons can't be escaped
+func (p *OrmPlugin) indexTagValue(ormable *OrmableType, name, value string) string {
+	if strings.ContainsAny(value, ";`") {
+		p.Fail("index", name, "of", ormable.OriginName, "has a semicolon or backquote in", value)
+	}
+	return strings.ReplaceAll(escapeTagValue(value), ",", `\\,`)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
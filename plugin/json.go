@@ -0,0 +1,89 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// isJSONList tells if the repeated field holds messages of a non-ormable type
+// which are stored together as a JSON array
+func (p *OrmPlugin) isJSONList(field *protogen.Field) bool {
+	return field.Desc.IsList() && field.Desc.Message() != nil &&
+		!p.isOrmable(p.fieldType(field)) && getFieldOptions(field).GetJson()
+}
+
+// generateJSONListConversion outputs the code converting a repeated message
+// to/from a JSON array, each element is encoded with protojson so the JSON
+// keys follow the json_name of the element fields
+func (p *OrmPlugin) generateJSONListConversion(field *protogen.Field, toORM bool) {
+	fieldName := fieldName(field)
+	if toORM {
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`temp`, fieldName, ` := make([]`, identJsonRawMessage, `, len(m.`, fieldName, `))`)
+		p.P(`for i, v := range m.`, fieldName, ` {`)
+		p.P(`if temp`, fieldName, `[i], err = `, identProtojsonMarshalFn, `(v); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		p.P(`if to.`, fieldName, `, err = `, identJsonMarshal, `(temp`, fieldName, `); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		return
+	}
+	p.P(`if len(m.`, fieldName, `) > 0 {`)
+	p.P(`var temp`, fieldName, ` []`, identJsonRawMessage)
+	p.P(`if err = `, identJsonUnmarshal, `(m.`, fieldName, `, &temp`, fieldName, `); err != nil {`)
+	p.P(`return to, err`)
+	p.P(`}`)
+	p.P(`to.`, fieldName, ` = make([]*`, field.Message.GoIdent, `, len(temp`, fieldName, `))`)
+	p.P(`for i, v := range temp`, fieldName, ` {`)
+	p.P(`to.`, fieldName, `[i] = &`, field.Message.GoIdent, `{}`)
+	p.P(`if err = `, identProtojsonUnmarshalFn, `(v, to.`, fieldName, `[i]); err != nil {`)
+	p.P(`return to, err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`}`)
+}
+
+// generateJSONArrayConversion outputs the code converting a repeated scalar or
+// enum to/from a JSON array, enums go through their stored values
+func (p *OrmPlugin) generateJSONArrayConversion(field *protogen.Field, toORM bool) {
+	fieldName := fieldName(field)
+	valueType := "int32"
+	if field.Enum != nil && p.enumAsString(field) {
+		valueType = "string"
+	}
+	if toORM {
+		source := `m.` + fieldName
+		p.P(`if m.`, fieldName, ` != nil {`)
+		if field.Enum != nil {
+			source = `temp` + fieldName
+			p.P(source, ` := make([]`, valueType, `, len(m.`, fieldName, `))`)
+			p.P(`for i, v := range m.`, fieldName, ` {`)
+			p.P(append([]interface{}{source, `[i] = `}, p.enumToORM(field, "v")...)...)
+			p.P(`}`)
+		}
+		p.P(`if to.`, fieldName, `, err = `, identJsonMarshal, `(`, source, `); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		return
+	}
+	p.P(`if len(m.`, fieldName, `) > 0 {`)
+	if field.Enum == nil {
+		p.P(`if err = `, identJsonUnmarshal, `(m.`, fieldName, `, &to.`, fieldName, `); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		return
+	}
+	p.P(`var temp`, fieldName, ` []`, valueType)
+	p.P(`if err = `, identJsonUnmarshal, `(m.`, fieldName, `, &temp`, fieldName, `); err != nil {`)
+	p.P(`return to, err`)
+	p.P(`}`)
+	p.P(`to.`, fieldName, ` = make([]`, field.Enum.GoIdent, `, len(temp`, fieldName, `))`)
+	p.P(`for i, v := range temp`, fieldName, ` {`)
+	p.P(append([]interface{}{`to.`, fieldName, `[i] = `}, p.enumToPB(field, "v", "value")...)...)
+	p.P(`}`)
+	p.P(`}`)
+}
@@ -6,21 +6,35 @@ var (
 	// stdlib idents
 	identCtx                = newKnownIdent("Context", "context")
 	identTime               = newKnownIdent("Time", "time")
+	identTimeDuration       = newKnownIdent("Duration", "time")
 	identStringsHasPrefixFn = newKnownIdent("HasPrefix", "strings")
 	identJsonMarshal        = newKnownIdent("Marshal", "encoding/json")
+	identJsonUnmarshal      = newKnownIdent("Unmarshal", "encoding/json")
+	identJsonRawMessage     = newKnownIdent("RawMessage", "encoding/json")
+	identStringsTrimPrefix  = newKnownIdent("TrimPrefix", "strings")
 	identFmtErrorf          = newKnownIdent("Errorf", "fmt")
 	// proto custom types
 	identTypesInet               = newKnownIdent("Inet", "github.com/kirinse/protoc-gen-gorm/types")
 	identTypesInetValue          = newKnownIdent("InetValue", "github.com/kirinse/protoc-gen-gorm/types")
+	identTypesInterval           = newKnownIdent("Interval", "github.com/kirinse/protoc-gen-gorm/types")
+	identTypesDate               = newKnownIdent("Date", "github.com/kirinse/protoc-gen-gorm/types")
+	identTypesNumeric            = newKnownIdent("Numeric", "github.com/kirinse/protoc-gen-gorm/types")
+	identTypesParseNumericFn     = newKnownIdent("ParseNumeric", "github.com/kirinse/protoc-gen-gorm/types")
 	identTypesUUIDValue          = newKnownIdent("UUIDValue", "github.com/kirinse/protoc-gen-gorm/types")
 	identTypesUUID               = newKnownIdent("UUID", "github.com/kirinse/protoc-gen-gorm/types")
 	identTypesJSONValue          = newKnownIdent("JSONValue", "github.com/kirinse/protoc-gen-gorm/types")
 	identTypesParseInetFn        = newKnownIdent("ParseInet", "github.com/kirinse/protoc-gen-gorm/types")
 	identTypesParseTimeFn        = newKnownIdent("ParseTime", "github.com/kirinse/protoc-gen-gorm/types")
 	identTypesTimeOnlyByStringFn = newKnownIdent("TimeOnlyByString", "github.com/kirinse/protoc-gen-gorm/types")
+	identTypesDeletedFilterFn    = newKnownIdent("DeletedFilterFromContext", "github.com/kirinse/protoc-gen-gorm/types")
+	identTypesIncludeDeleted     = newKnownIdent("IncludeDeleted", "github.com/kirinse/protoc-gen-gorm/types")
+	identTypesOnlyDeleted        = newKnownIdent("OnlyDeleted", "github.com/kirinse/protoc-gen-gorm/types")
 	// gorm idents
 	identGormDB         = newKnownIdent("DB", "gorm.io/gorm")
+	identGormSession    = newKnownIdent("Session", "gorm.io/gorm")
+	identGormDeletedAt  = newKnownIdent("DeletedAt", "gorm.io/gorm")
 	identGormJSON       = newKnownIdent("JSON", "gorm.io/datatypes")
+	identClauseLocking  = newKnownIdent("Locking", "gorm.io/gorm/clause")
 	identpqBoolArray    = newKnownIdent("BoolArray", "github.com/lib/pq")
 	identpqFloat32Array = newKnownIdent("Float32Array", "github.com/lib/pq")
 	identpqFloat64Array = newKnownIdent("Float64Array", "github.com/lib/pq")
@@ -30,11 +44,25 @@ var (
 	// timestamp idents
 	identTimestamp      = newKnownIdent("Timestamp", "github.com/golang/protobuf/ptypes")
 	identTimestampProto = newKnownIdent("TimestampProto", "github.com/golang/protobuf/ptypes")
+	identTimestampNewFn = newKnownIdent("New", "google.golang.org/protobuf/types/known/timestamppb")
+	// duration idents
+	identDurationNewFn = newKnownIdent("New", "google.golang.org/protobuf/types/known/durationpb")
+	// proto idents
+	identProtoSizeFn = newKnownIdent("Size", "google.golang.org/protobuf/proto")
+	// protojson idents
+	identProtojsonMarshalFn   = newKnownIdent("Marshal", "google.golang.org/protobuf/encoding/protojson")
+	identProtojsonUnmarshalFn = newKnownIdent("Unmarshal", "google.golang.org/protobuf/encoding/protojson")
 	// error idents
 	identNilArgumentError             = newKnownIdent("NilArgumentError", "github.com/kirinse/protoc-gen-gorm/errors")
 	identEmptyIDError                 = newKnownIdent("EmptyIdError", "github.com/kirinse/protoc-gen-gorm/errors")
 	identBadRepeatedFieldMaskTplError = newKnownIdent("BadRepeatedFieldMaskTpl", "github.com/kirinse/protoc-gen-gorm/errors")
 	identNoTransactionError           = newKnownIdent("NoTransactionError", "github.com/kirinse/protoc-gen-gorm/errors")
+	identImmutableFieldMaskTplError   = newKnownIdent("ImmutableFieldMaskTpl", "github.com/kirinse/protoc-gen-gorm/errors")
+	identVersionConflictError         = newKnownIdent("VersionConflictError", "github.com/kirinse/protoc-gen-gorm/errors")
+	identIsVersionConflictFn          = newKnownIdent("IsVersionConflict", "github.com/kirinse/protoc-gen-gorm/errors")
+	// grpc status idents
+	identStatusErrorFn           = newKnownIdent("Error", "google.golang.org/grpc/status")
+	identCodesFailedPrecondition = newKnownIdent("FailedPrecondition", "google.golang.org/grpc/codes")
 	// field selection idents
 	identQueryFieldSelection = newKnownIdent("FieldSelection", "github.com/kirinse/atlas-app-toolkit/query")
 	identQueryPagination     = newKnownIdent("Pagination", "github.com/kirinse/atlas-app-toolkit/query")
@@ -65,16 +93,40 @@ var (
 	identGetAccountIDFn = newKnownIdent("GetAccountID", "github.com/kirinse/atlas-app-toolkit/auth")
 	// fieldMask ident
 	identFieldMask = newKnownIdent("FieldMask", "google.golang.org/genproto/protobuf/field_mask")
-	// uuid idents
-	identNilUUID          = newKnownIdent("Nil", "github.com/satori/go.uuid")
-	identUUID             = newKnownIdent("UUID", "github.com/satori/go.uuid")
-	identUUIDFromStringFn = newKnownIdent("FromString", "github.com/satori/go.uuid")
 )
 
+// uuidLibrary holds the idents of a UUID implementation
+type uuidLibrary struct {
+	UUID    protogen.GoIdent
+	Nil     protogen.GoIdent
+	ParseFn protogen.GoIdent
+}
+
+// uuidLibraries are the UUID implementations the generated code can use
+var uuidLibraries = map[string]uuidLibrary{
+	"google": newUUIDLibrary("github.com/google/uuid", "Parse"),
+	"gofrs":  newUUIDLibrary("github.com/gofrs/uuid", "FromString"),
+	"satori": newUUIDLibrary("github.com/satori/go.uuid", "FromString"),
+}
+
+const defaultUUIDLibrary = "satori"
+
+func newUUIDLibrary(goImportPath, parseFn string) uuidLibrary {
+	return uuidLibrary{
+		UUID:    newKnownIdent("UUID", goImportPath),
+		Nil:     newKnownIdent("Nil", goImportPath),
+		ParseFn: newKnownIdent(parseFn, goImportPath),
+	}
+}
+
 var specialImports = map[string]struct{}{
-	"github.com/kirinse/protoc-gen-gorm/types":              {},
-	"github.com/kirinse/atlas-app-toolkit/rpc/resource": {},
-	"github.com/golang/protobuf/ptypes/timestamp":            {},
+	"github.com/kirinse/protoc-gen-gorm/types":           {},
+	"github.com/kirinse/atlas-app-toolkit/rpc/resource":  {},
+	"github.com/golang/protobuf/ptypes/timestamp":        {},
+	"google.golang.org/protobuf/types/known/durationpb":  {},
+	"google.golang.org/genproto/googleapis/type/date":    {},
+	"google.golang.org/genproto/googleapis/type/decimal": {},
+	"google.golang.org/protobuf/types/known/structpb":    {},
 }
 
 func newKnownIdent(goName, goImportPath string) protogen.GoIdent {
@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// isMapSupported tells if the map field can be stored as a JSON column, JSON
+// objects only accept string and integer keys
+func isMapSupported(field *protogen.Field) bool {
+	return field.Desc.IsMap() && field.Desc.MapKey().Kind() != protoreflect.BoolKind
+}
+
+// mapKeyType is the Go type of the map keys
+func mapKeyType(field *protogen.Field) string {
+	return protoPrimitiveKinds[field.Desc.MapKey().Kind()]
+}
+
+// mapValueType is the qualified Go type of the map values
+func (p *OrmPlugin) mapValueType(field *protogen.Field) string {
+	value := field.Message.Fields[1]
+	switch {
+	case value.Message != nil:
+		return p.qualifiedGoIdentPtr(value.Message.GoIdent)
+	case value.Enum != nil:
+		return p.qualifiedGoIdent(value.Enum.GoIdent)
+	default:
+		return protoPrimitiveKinds[value.Desc.Kind()]
+	}
+}
+
+// generateMapConversion outputs the code converting a map to/from a JSON
+// column, message values are encoded with protojson
+func (p *OrmPlugin) generateMapConversion(field *protogen.Field, toORM bool) {
+	if !isMapSupported(field) {
+		return
+	}
+	fieldName := fieldName(field)
+	keyType := mapKeyType(field)
+	value := field.Message.Fields[1]
+	if value.Message == nil {
+		if toORM {
+			p.P(`if m.`, fieldName, ` != nil {`)
+			p.P(`if to.`, fieldName, `, err = `, identJsonMarshal, `(m.`, fieldName, `); err != nil {`)
+		} else {
+			p.P(`if len(m.`, fieldName, `) > 0 {`)
+			p.P(`if err = `, identJsonUnmarshal, `(m.`, fieldName, `, &to.`, fieldName, `); err != nil {`)
+		}
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		return
+	}
+	if toORM {
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`temp`, fieldName, ` := make(map[`, keyType, `]`, identJsonRawMessage, `, len(m.`, fieldName, `))`)
+		p.P(`for k, v := range m.`, fieldName, ` {`)
+		p.P(`if temp`, fieldName, `[k], err = `, identProtojsonMarshalFn, `(v); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		p.P(`if to.`, fieldName, `, err = `, identJsonMarshal, `(temp`, fieldName, `); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+	} else {
+		p.P(`if len(m.`, fieldName, `) > 0 {`)
+		p.P(`var temp`, fieldName, ` map[`, keyType, `]`, identJsonRawMessage)
+		p.P(`if err = `, identJsonUnmarshal, `(m.`, fieldName, `, &temp`, fieldName, `); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`to.`, fieldName, ` = make(map[`, keyType, `]`, p.mapValueType(field), `, len(temp`, fieldName, `))`)
+		p.P(`for k, v := range temp`, fieldName, ` {`)
+		p.P(`to.`, fieldName, `[k] = &`, value.Message.GoIdent, `{}`)
+		p.P(`if err = `, identProtojsonUnmarshalFn, `(v, to.`, fieldName, `[k]); err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`}`)
+		p.P(`}`)
+	}
+}
+
+// generateMapApplyFieldMask outputs the field mask handling of a map, the
+// whole map is replaced by its own path and single entries of string keyed
+// maps are set or removed by a path suffixed with the key
+func (p *OrmPlugin) generateMapApplyFieldMask(field *protogen.Field) {
+	ccName := fieldName(field)
+	p.P(`if f == prefix+"`, ccName, `" {`)
+	p.P(`patchee.`, ccName, ` = patcher.`, ccName)
+	p.P(`continue`)
+	p.P(`}`)
+	if mapKeyType(field) != "string" {
+		return
+	}
+	p.P(`if `, identStringsHasPrefixFn, `(f, prefix+"`, ccName, `.") {`)
+	p.P(`key := `, identStringsTrimPrefix, `(f, prefix+"`, ccName, `.")`)
+	p.P(`if v, ok := patcher.`, ccName, `[key]; ok {`)
+	p.P(`if patchee.`, ccName, ` == nil {`)
+	p.P(`patchee.`, ccName, ` = make(map[string]`, p.mapValueType(field), `)`)
+	p.P(`}`)
+	p.P(`patchee.`, ccName, `[key] = v`)
+	p.P(`} else {`)
+	p.P(`delete(patchee.`, ccName, `, key)`)
+	p.P(`}`)
+	p.P(`continue`)
+	p.P(`}`)
+}
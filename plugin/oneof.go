@@ -0,0 +1,252 @@
+package plugin
+
+import (
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// isOneofField tells if the field is a member of a real oneof, proto3 optional
+// fields live in synthetic oneofs and are handled as plain fields
+func isOneofField(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
+
+// isFirstOneofField tells if the field opens its oneof, per-oneof code is
+// generated once, when the first member is met
+func isFirstOneofField(field *protogen.Field) bool {
+	return isOneofField(field) && field.Oneof.Fields[0] == field
+}
+
+func oneofAsJSON(oneof *protogen.Oneof) bool {
+	return getOneofOptions(oneof).GetJson()
+}
+
+// oneofCaseName is the name of the discriminator field of the ORM object
+func oneofCaseName(oneof *protogen.Oneof) string {
+	return oneof.GoName + "Case"
+}
+
+// oneofCaseValue is the discriminator value stored for a oneof member
+func oneofCaseValue(field *protogen.Field) string {
+	return string(field.Desc.Name())
+}
+
+// isOneofMemberSupported tells if the member of a oneof can be stored in its
+// own nullable column
+func (p *OrmPlugin) isOneofMemberSupported(field *protogen.Field) bool {
+	if field.Desc.Message() == nil {
+		return true
+	}
+	coreType := string(field.Desc.Message().Name())
+	if _, exists := wellKnownTypes[coreType]; exists {
+		return true
+	}
+	return coreType == protoTypeTimestamp || p.isOrmable(p.fieldType(field))
+}
+
+// parseOneof registers the ORM fields of a oneof that are not derived from
+// the oneof members: the discriminator column, or the single JSON column
+func (p *OrmPlugin) parseOneof(ormable *OrmableType, oneof *protogen.Oneof) {
+	opts := getOneofOptions(oneof)
+	fieldName := oneofCaseName(oneof)
+	f := &Field{
+		F:                &protogen.Field{GoIdent: protogen.GoIdent{GoName: "string"}},
+		Type:             "string",
+		GormFieldOptions: &gorm.GormFieldOptions{Tag: opts.GetTag()},
+	}
+	if opts.GetJson() {
+		fieldName = oneof.GoName
+		f.F.GoIdent = identGormJSON
+		f.Type = identGormJSON.GoName
+	}
+	if _, ok := ormable.Fields[fieldName]; ok {
+		p.Fail("Cannot include", fieldName, "field of oneof", oneof.GoName, "into", ormable.Name, "as it already exists there.")
+	}
+	ormable.Fields[fieldName] = f
+}
+
+// generateOneofConversion outputs the code converting a whole oneof to/from orm
+func (p *OrmPlugin) generateOneofConversion(message *protogen.Message, oneof *protogen.Oneof, toORM bool) {
+	oneofName := oneof.GoName
+	if oneofAsJSON(oneof) {
+		if toORM {
+			p.P(`if m.`, oneofName, ` != nil {`)
+			p.P(`if to.`, oneofName, `, err = `, identProtojsonMarshalFn, `(&`, message.GoIdent, `{`, oneofName, `: m.`, oneofName, `}); err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+			p.P(`}`)
+		} else {
+			p.P(`if len(m.`, oneofName, `) > 0 {`)
+			p.P(`temp`, oneofName, ` := &`, message.GoIdent, `{}`)
+			p.P(`if err = `, identProtojsonUnmarshalFn, `(m.`, oneofName, `, temp`, oneofName, `); err != nil {`)
+			p.P(`return to, err`)
+			p.P(`}`)
+			p.P(`to.`, oneofName, ` = temp`, oneofName, `.`, oneofName)
+			p.P(`}`)
+		}
+		return
+	}
+
+	var members []*protogen.Field
+	for _, field := range oneof.Fields {
+		if !getFieldOptions(field).GetDrop() && p.isOneofMemberSupported(field) {
+			members = append(members, field)
+		}
+	}
+	if len(members) == 0 {
+		return
+	}
+	if toORM {
+		p.P(`switch v := m.`, oneofName, `.(type) {`)
+	} else {
+		p.P(`switch m.`, oneofCaseName(oneof), ` {`)
+	}
+	for _, field := range members {
+		if toORM {
+			p.P(`case *`, p.oneofWrappers[field], `:`)
+			p.P(`to.`, oneofCaseName(oneof), ` = "`, oneofCaseValue(field), `"`)
+			p.generateOneofMemberToORM(field)
+		} else {
+			p.P(`case "`, oneofCaseValue(field), `":`)
+			p.generateOneofMemberToPB(field)
+		}
+	}
+	p.P(`}`)
+}
+
+func (p *OrmPlugin) generateOneofMemberToORM(field *protogen.Field) {
+	fieldName := fieldName(field)
+	desc := field.Desc
+	switch {
+	case desc.Enum() != nil:
+		p.P(append([]interface{}{`temp`, fieldName, ` := `}, p.enumToORM(field, "v."+fieldName)...)...)
+		p.P(`to.`, fieldName, ` = &temp`, fieldName)
+	case desc.Message() == nil:
+		if field.GoIdent.GoName == "[]byte" {
+			p.P(`to.`, fieldName, ` = v.`, fieldName)
+		} else {
+			p.P(`temp`, fieldName, ` := v.`, fieldName)
+			p.P(`to.`, fieldName, ` = &temp`, fieldName)
+		}
+	case string(desc.Message().Name()) == protoTypeTimestamp:
+		p.P(`if v.`, fieldName, ` != nil {`)
+		p.P(`if !v.`, fieldName, `.IsValid() {`)
+		p.P(`return to, `, identFmtErrorf, `("`, fieldName, ` invalid")`)
+		p.P(`}`)
+		p.P(`t := v.`, fieldName, `.AsTime()`)
+		p.P(`to.`, fieldName, ` = &t`)
+		p.P(`}`)
+	case p.isOrmable(p.fieldType(field)):
+		p.P(`if v.`, fieldName, ` != nil {`)
+		p.P(`temp`, fieldName, `, err := v.`, fieldName, `.ToORM(ctx)`)
+		p.P(`if err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`to.`, fieldName, ` = &temp`, fieldName)
+		p.P(`}`)
+	case isBytesValue(field):
+		p.P(`if v.`, fieldName, ` != nil {`)
+		p.P(`to.`, fieldName, ` = append([]byte{}, v.`, fieldName, `.Value...)`)
+		p.P(`}`)
+	default: // well known wrapper type
+		p.P(`if v.`, fieldName, ` != nil {`)
+		p.P(`temp`, fieldName, ` := v.`, fieldName, `.Value`)
+		p.P(`to.`, fieldName, ` = &temp`, fieldName)
+		p.P(`}`)
+	}
+}
+
+func (p *OrmPlugin) generateOneofMemberToPB(field *protogen.Field) {
+	fieldName := fieldName(field)
+	wrapper := p.oneofWrappers[field]
+	desc := field.Desc
+	switch {
+	case desc.Enum() != nil:
+		p.P(`if m.`, fieldName, ` != nil {`)
+		value := p.enumToPB(field, "*m."+fieldName, "value")
+		p.P(append(append([]interface{}{`to.`, field.Oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: `}, value...), `}`)...)
+		p.P(`}`)
+	case desc.Message() == nil:
+		if field.GoIdent.GoName == "[]byte" {
+			p.P(`to.`, field.Oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: m.`, fieldName, `}`)
+		} else {
+			p.P(`if m.`, fieldName, ` != nil {`)
+			p.P(`to.`, field.Oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: *m.`, fieldName, `}`)
+			p.P(`}`)
+		}
+	case string(desc.Message().Name()) == protoTypeTimestamp:
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`to.`, field.Oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: `, identTimestampNewFn, `(*m.`, fieldName, `)}`)
+		p.P(`}`)
+	case p.isOrmable(p.fieldType(field)):
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToPB(ctx)`)
+		p.P(`if err != nil {`)
+		p.P(`return to, err`)
+		p.P(`}`)
+		p.P(`to.`, field.Oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: &temp`, fieldName, `}`)
+		p.P(`}`)
+	case isBytesValue(field):
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`to.`, field.Oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: &`, fieldIdent(field), `{Value: m.`, fieldName, `}}`)
+		p.P(`}`)
+	default: // well known wrapper type
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`to.`, field.Oneof.GoName, ` = &`, wrapper, `{`, fieldName, `: &`, fieldIdent(field), `{Value: *m.`, fieldName, `}}`)
+		p.P(`}`)
+	}
+}
+
+// generateOneofApplyFieldMask outputs the field mask handling of a oneof, both
+// the oneof name and its members are accepted as paths
+func (p *OrmPlugin) generateOneofApplyFieldMask(oneof *protogen.Oneof) {
+	oneofName := oneof.GoName
+	p.P(`if f == prefix+"`, oneofName, `" {`)
+	p.P(`patchee.`, oneofName, ` = patcher.`, oneofName)
+	p.P(`continue`)
+	p.P(`}`)
+	for _, field := range oneof.Fields {
+		ccName := fieldName(field)
+		wrapper := p.oneofWrappers[field]
+		if p.isOneofNestedPatch(field) {
+			applyFn := protogen.GoIdent{
+				GoName:       "DefaultApplyFieldMask" + field.Message.GoIdent.GoName,
+				GoImportPath: field.Message.GoIdent.GoImportPath,
+			}
+			p.P(`if !updated`, ccName, ` && `, identStringsHasPrefixFn, `(f, prefix+"`, ccName, `.") {`)
+			p.P(`updated`, ccName, ` = true`)
+			p.P(`if patcher.Get`, ccName, `() == nil {`)
+			p.P(`if _, ok := patchee.`, oneofName, `.(*`, wrapper, `); ok {`)
+			p.P(`patchee.`, oneofName, ` = nil`)
+			p.P(`}`)
+			p.P(`continue`)
+			p.P(`}`)
+			p.P(`patchee`, ccName, ` := patchee.Get`, ccName, `()`)
+			p.P(`if patchee`, ccName, ` == nil {`)
+			p.P(`patchee`, ccName, ` = &`, field.Message.GoIdent, `{}`)
+			p.P(`}`)
+			p.P(`if o, err := `, applyFn, `(ctx, patchee`, ccName, `, patcher.Get`, ccName, `(), &`, identFieldMask,
+				`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
+			p.P(`return nil, err`)
+			p.P(`} else {`)
+			p.P(`patchee.`, oneofName, ` = &`, wrapper, `{`, ccName, `: o}`)
+			p.P(`}`)
+			p.P(`continue`)
+			p.P(`}`)
+		}
+		p.P(`if f == prefix+"`, ccName, `" {`)
+		p.P(`if _, ok := patcher.`, oneofName, `.(*`, wrapper, `); ok {`)
+		p.P(`patchee.`, oneofName, ` = patcher.`, oneofName)
+		p.P(`} else if _, ok := patchee.`, oneofName, `.(*`, wrapper, `); ok {`)
+		p.P(`patchee.`, oneofName, ` = nil`)
+		p.P(`}`)
+		p.P(`continue`)
+		p.P(`}`)
+	}
+}
+
+// isOneofNestedPatch tells if paths into the oneof member are patched
+// recursively by the DefaultApplyFieldMask of the member type
+func (p *OrmPlugin) isOneofNestedPatch(field *protogen.Field) bool {
+	return field.Desc.Message() != nil && p.isOrmable(p.fieldType(field))
+}
@@ -0,0 +1,100 @@
+package plugin
+
+import (
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parsePermissions validates the permissions of the fields of the message,
+// they restrict columns of the message other than its primary keys
+func (p *OrmPlugin) parsePermissions(message *protogen.Message) {
+	ormable := p.getOrmableMessage(message)
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		if field.GetSkipMigration() && field.GetTag().GetIgnore() {
+			p.Fail("field", fieldName, "of", ormable.OriginName, "is ignored and skips the migration")
+		}
+		permission := field.GetPermission()
+		if permission == gorm.FieldPermission_FIELD_PERMISSION_READ_WRITE {
+			continue
+		}
+		if _, column := p.findColumnField(ormable, fieldName); column == nil || field.F.Desc == nil || isOneofField(field.F) {
+			p.Fail("field", fieldName, "of", ormable.OriginName, "has a permission but is not a column of the message")
+		}
+		if field.GetTag().GetPrimaryKey() || containsString(p.primaryKeyNames(ormable), fieldName) {
+			p.Fail("primary key", fieldName, "of", ormable.OriginName, "has a permission")
+		}
+		if permission == gorm.FieldPermission_FIELD_PERMISSION_WRITE_ONLY {
+			desc := field.F.Desc
+			if desc.IsList() || desc.IsMap() || desc.Message() != nil || desc.Enum() != nil {
+				p.Fail("write-only field", fieldName, "of", ormable.OriginName, "must be a scalar")
+			}
+		}
+	}
+}
+
+func isImmutableField(field *protogen.Field) bool {
+	switch getFieldOptions(field).GetPermission() {
+	case gorm.FieldPermission_FIELD_PERMISSION_READ_ONLY, gorm.FieldPermission_FIELD_PERMISSION_CREATE_ONLY:
+		return true
+	}
+	return false
+}
+
+func isWriteOnlyField(field *protogen.Field) bool {
+	return getFieldOptions(field).GetPermission() == gorm.FieldPermission_FIELD_PERMISSION_WRITE_ONLY
+}
+
+// hasPermissions tells if strict updates keep stored values of the ormable
+func hasPermissions(ormable *OrmableType) bool {
+	for _, field := range ormable.Fields {
+		if field.GetPermission() != gorm.FieldPermission_FIELD_PERMISSION_READ_WRITE {
+			return true
+		}
+	}
+	return false
+}
+
+// generateKeptFields keeps the stored values the strict update can't change,
+// the read-only fields and, of an existing row, the create-only fields and the
+// write-only fields the update doesn't set
+func (p *OrmPlugin) generateKeptFields(ormable *OrmableType) {
+	var existing []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		switch ormable.Fields[fieldName].GetPermission() {
+		case gorm.FieldPermission_FIELD_PERMISSION_READ_ONLY:
+			p.P(`ormObj.`, fieldName, ` = lockedRow.`, fieldName)
+		case gorm.FieldPermission_FIELD_PERMISSION_CREATE_ONLY, gorm.FieldPermission_FIELD_PERMISSION_WRITE_ONLY:
+			existing = append(existing, fieldName)
+		}
+	}
+	if len(existing) == 0 {
+		return
+	}
+	p.P(`if count != 0 {`)
+	for _, fieldName := range existing {
+		field := ormable.Fields[fieldName]
+		if field.GetPermission() == gorm.FieldPermission_FIELD_PERMISSION_CREATE_ONLY {
+			p.P(`ormObj.`, fieldName, ` = lockedRow.`, fieldName)
+			continue
+		}
+		p.P(`if `, zeroCondition(`ormObj.`+fieldName, field.F.Desc), ` {`)
+		p.P(`ormObj.`, fieldName, ` = lockedRow.`, fieldName)
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
+
+// zeroCondition tells if the ORM value of a scalar field is not set
+func zeroCondition(value string, desc protoreflect.FieldDescriptor) string {
+	switch {
+	case desc.HasPresence():
+		return value + ` == nil`
+	case desc.Kind() == protoreflect.StringKind || desc.Kind() == protoreflect.BytesKind:
+		return `len(` + value + `) == 0`
+	case desc.Kind() == protoreflect.BoolKind:
+		return `!` + value
+	}
+	return value + ` == 0`
+}
@@ -8,7 +8,6 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
-	"gorm.io/gorm/schema"
 	"log"
 	"sort"
 	"strings"
@@ -19,6 +18,12 @@ const (
 	typeEnum    = 14
 
 	protoTypeTimestamp = "Timestamp" // last segment, first will be *google_protobufX
+	protoTypeDuration  = "Duration"
+	protoTypeDate      = "Date"
+	protoTypeDecimal   = "Decimal"
+	protoTypeStruct    = "Struct"
+	protoTypeValue     = "Value"
+	protoTypeListValue = "ListValue"
 	protoTypeJSON      = "JSONValue"
 	protoTypeUUID      = "UUID"
 	protoTypeUUIDValue = "UUIDValue"
@@ -36,7 +41,7 @@ var wellKnownTypes = map[string]string{
 	"UInt32Value": "*uint32",
 	"UInt64Value": "*uint64",
 	"BoolValue":   "*bool",
-	//  "BytesValue" : "*[]byte",
+	"BytesValue":  "[]byte",
 }
 
 var protoPrimitiveKinds = map[protoreflect.Kind]string{
@@ -82,6 +87,8 @@ type OrmableType struct {
 	Fields     map[string]*Field
 	debug      map[string]bool
 	Methods    map[string]*autogenMethod
+	// Version is the Go name of the field guarding the updates
+	Version string
 }
 
 type Field struct {
@@ -92,6 +99,10 @@ type Field struct {
 
 	*gorm.GormFieldOptions
 	ParentOriginName string
+	// Indexes are the settings of the message level indexes over the field
+	Indexes []string
+	// Check is the message level check constraint held by the field
+	Check string
 }
 
 func NewOrmableType(oname string, msg *protogen.Message, file *protogen.File) *OrmableType {
@@ -111,6 +122,8 @@ type OrmPlugin struct {
 	StringEnums      bool
 	Gateway          bool
 	DefaultHandlers  bool
+	UUIDLibrary      string
+	Dialect          string
 	ormableTypes     OrmableLookup
 	EmptyFiles       []string
 	currentPackage   protogen.GoImportPath
@@ -118,6 +131,10 @@ type OrmPlugin struct {
 	fileName         string
 	messages         map[string]struct{}
 	ormableServices  []autogenService
+	oneofWrappers    map[*protogen.Field]protogen.GoIdent
+	nativeEnums      map[*protogen.File][]*protogen.Enum
+	nativeEnumKeys   map[string]string
+	uuid             uuidLibrary
 }
 
 func (p *OrmPlugin) Fail(args ...string) {
@@ -143,6 +160,10 @@ func (p *OrmPlugin) Init(g *protogen.Plugin) {
 	p.Plugin = g
 	p.messages = make(map[string]struct{})
 	p.ormableTypes = make(map[string]*OrmableType)
+	p.oneofWrappers = make(map[*protogen.Field]protogen.GoIdent)
+	p.nativeEnums = make(map[*protogen.File][]*protogen.Enum)
+	p.nativeEnumKeys = make(map[string]string)
+	p.checkDialect()
 
 	// params := g.Request.GetParameter()
 	// if strings.EqualFold(g.Param["enums"], "string") {
@@ -164,6 +185,7 @@ func (p *OrmPlugin) Generate() {
 	skipped := make([]string, 0)
 	for _, file := range p.Plugin.Files {
 		p.currentPackage = file.GoImportPath
+		p.setUUIDLibrary(file)
 		if file.Generate {
 			outfile := p.NewGeneratedFile(file.GeneratedFilenamePrefix+".pb.gorm.go", p.currentPackage)
 			p.setFile(outfile)
@@ -174,32 +196,40 @@ func (p *OrmPlugin) Generate() {
 			skipped = append(skipped, file.GeneratedFilenamePrefix)
 		}
 		// Preload just the types we'll be creating
-		for _, msg := range file.Messages {
-			// We don't want to bother with the MapEntry stuff
-			if msg.Desc.IsMapEntry() {
-				continue
-			}
-
+		for _, msg := range allMessages(file.Messages) {
 			typeName := messageName(msg)
 			p.messages[typeName] = struct{}{}
 
 			if getMessageOptions(msg).GetOrmable() && !p.isOrmable(typeName) {
 				p.ormableTypes[typeName] = NewOrmableType(typeName, msg, file)
 			}
+			// Keep the oneof wrapper types, field idents are rewritten to ORM types later
+			for _, field := range msg.Fields {
+				if isOneofField(field) {
+					p.oneofWrappers[field] = field.GoIdent
+				}
+			}
 		}
-		for _, msg := range file.Messages {
+		for _, msg := range allMessages(file.Messages) {
 			if p.isOrmableMessage(msg) {
 				p.parseBasicFields(msg)
 			}
 		}
-		for _, msg := range file.Messages {
+		for _, msg := range allMessages(file.Messages) {
 			if p.isOrmableMessage(msg) {
 				p.parseAssociations(msg)
 				o := p.getOrmableMessage(msg)
 				if p.hasPrimaryKey(o) {
-					_, fd := p.findPrimaryKey(o)
-					fd.ParentOriginName = o.OriginName
+					_, pks := p.findPrimaryKeys(o)
+					for _, pk := range pks {
+						pk.ParentOriginName = o.OriginName
+					}
 				}
+				p.parseIndexes(msg)
+				p.parseConstraints(msg)
+				p.parseVersion(msg)
+				p.parseTimestamps(msg)
+				p.parsePermissions(msg)
 			}
 		}
 		p.parseServices(file)
@@ -207,7 +237,8 @@ func (p *OrmPlugin) Generate() {
 	for file, generated := range generatedFileLookup {
 		p.setFile(generated)
 		p.currentPackage = file.GoImportPath
-		for _, msg := range file.Messages {
+		p.setUUIDLibrary(file)
+		for _, msg := range allMessages(file.Messages) {
 			if !p.isOrmableMessage(msg) {
 				continue
 			}
@@ -216,6 +247,7 @@ func (p *OrmPlugin) Generate() {
 			p.generateConvertFunctions(msg)
 			p.generateHookInterfaces(msg)
 		}
+		p.generateNativeEnumTypes(file)
 		p.generateDefaultHandlers(file)
 		p.generateDefaultServer(file)
 	}
@@ -228,6 +260,9 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 	ormable.Name = fmt.Sprintf("%sORM", typeName)
 
 	for _, field := range msg.Fields {
+		if isFirstOneofField(field) {
+			p.parseOneof(ormable, field.Oneof)
+		}
 		fieldOpts := getFieldOptions(field)
 		if fieldOpts == nil {
 			fieldOpts = &gorm.GormFieldOptions{}
@@ -235,6 +270,15 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 		if fieldOpts.GetDrop() {
 			continue
 		}
+		if isOneofField(field) {
+			if oneofAsJSON(field.Oneof) {
+				continue
+			}
+			if !p.isOneofMemberSupported(field) {
+				p.warning("oneof member %s of type %s cannot be stored in its own column", field.GoName, p.fieldType(field))
+				continue
+			}
+		}
 		tag := fieldOpts.GetTag()
 		desc := field.Desc
 		fieldName := field.GoName
@@ -242,7 +286,9 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 		fieldType := p.fieldType(field)
 
 		var typePackage string
-		if p.IsAbleToMakePQArray(fieldType) {
+		if p.isJSONArray(field) {
+			field.GoIdent = identGormJSON
+		} else if p.IsAbleToMakePQArray(fieldType) {
 			ident, tagString, err := p.fieldToPQArrayIdent(field)
 			if err != nil {
 				continue
@@ -250,30 +296,67 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			fieldType = p.qualifiedGoIdent(ident)
 			field.GoIdent = ident
 			fieldOpts.Tag = tagWithType(tag, tagString)
+		} else if desc.Enum() != nil && desc.IsList() {
+			ident, tagString := p.enumToPQArrayIdent(field)
+			field.GoIdent = ident
+			fieldOpts.Tag = p.nativeEnumTag(ormable, field, tagWithType(tag, tagString))
+		} else if desc.IsMap() {
+			if !isMapSupported(field) {
+				p.warning("map %s with %s keys cannot be stored as a JSON object", fieldName, desc.MapKey().Kind())
+				continue
+			}
+			field.GoIdent = identGormJSON
+		} else if p.isJSONList(field) {
+			field.GoIdent = identGormJSON
 		} else if (desc.Message() != nil || !p.isOrmable(fieldType)) && desc.IsList() {
 			// Not implemented yet
 			continue
 		} else if desc.Enum() != nil {
 			field.GoIdent.GoName = "int32"
-			if p.StringEnums {
+			if p.enumAsString(field) {
 				field.GoIdent.GoName = "string"
 			}
+			fieldOpts.Tag = p.nativeEnumTag(ormable, field, tag)
 		} else if desc.Message() != nil {
 
 			fieldType = string(desc.Message().Name())
 			parts := strings.Split(fieldType, ".")
 			rawType := parts[len(parts)-1]
-			//Check for WKTs or fields of nonormable types
-			if v, exists := wellKnownTypes[rawType]; exists {
+			//Check for user mappings, WKTs or fields of nonormable types
+			if mapping, ok := p.typeMapping(field); ok {
+				field.GoIdent = p.typeMappingIdent(mapping, mapping.GetGoType())
+				if tag.GetType() == "" && mapping.ColumnType != nil {
+					fieldOpts.Tag = tagWithType(tag, mapping.GetColumnType())
+				}
+			} else if v, exists := wellKnownTypes[rawType]; exists {
 				field.GoIdent.GoName = v
 			} else if rawType == protoTypeUUID {
-				field.GoIdent = identUUID
+				field.GoIdent = p.uuid.UUID
 				//fieldOpts.Tag = tagWithType(tag, "uuid")
 			} else if rawType == protoTypeUUIDValue {
-				field.GoIdent = ptrIdent(identUUID)
+				field.GoIdent = ptrIdent(p.uuid.UUID)
 				//fieldOpts.Tag = tagWithType(tag, "uuid")
 			} else if rawType == protoTypeTimestamp {
 				field.GoIdent = ptrIdent(identTime)
+			} else if isStructMessage(desc.Message()) {
+				field.GoIdent = identGormJSON
+			} else if isDateMessage(desc.Message()) {
+				field.GoIdent = ptrIdent(identTypesDate)
+			} else if isDecimalMessage(desc.Message()) {
+				field.GoIdent = ptrIdent(identTypesNumeric)
+				if columnType := p.decimalColumnType(tag); tag.GetType() == "" && columnType != "" {
+					fieldOpts.Tag = tagWithType(tag, columnType)
+				}
+			} else if rawType == protoTypeDuration {
+				field.GoIdent = ptrIdent(identTimeDuration)
+				if strings.EqualFold(tag.GetType(), "interval") {
+					if p.isPostgres() {
+						field.GoIdent = ptrIdent(identTypesInterval)
+					} else {
+						p.warning("interval column of %s is postgres only, stored as a duration", fieldName)
+						tag.Type = nil
+					}
+				}
 			} else if rawType == protoTypeJSON {
 				field.GoIdent = identGormJSON
 				// fieldOpts.Tag = tagWithType(tag, "jsonb")
@@ -290,9 +373,10 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 				switch ttype {
 				case "uuid", "text", "char", "array", "cidr", "inet", "macaddr":
 					fieldType = "*string"
-				case "smallint", "integer", "bigint", "numeric", "smallserial", "serial", "bigserial":
+				case "smallint", "integer", "bigint", "numeric", "smallserial", "serial", "bigserial",
+					"int", "tinyint", "mediumint":
 					fieldType = "*int64"
-				case "jsonb", "bytea":
+				case "jsonb", "bytea", "json", "blob", "longblob", "binary", "varbinary":
 					fieldType = "[]byte"
 				case "":
 					fieldType = "interface{}" // we do not know the type yet (if it association we will fix the type later)
@@ -307,16 +391,22 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			} else if rawType == protoTypeInet {
 				field.GoIdent = ptrIdent(identTypesInet)
 				// typePackage = gtypesImport
-				fieldOpts.Tag = tagWithType(tag, "inet")
+				fieldOpts.Tag = tagWithType(tag, p.inetColumnType())
 			} else if rawType == protoTimeOnly {
 				field.GoIdent.GoName = "string"
 				fieldOpts.Tag = tagWithType(tag, "time")
+			} else if p.isEmbeddedMessage(field) {
+				p.parseEmbedded(ormable, field)
+				continue
 			} else {
 				continue
 			}
 		} else {
 			field.GoIdent.GoName = fieldType
 		}
+		if isOneofField(field) || isOptionalScalar(field) {
+			nullableScalar(field)
+		}
 
 		f := &Field{F: field, Type: field.GoIdent.GoName, Package: typePackage, GormFieldOptions: fieldOpts}
 
@@ -343,6 +433,9 @@ func (p *OrmPlugin) parseBasicFields(msg *protogen.Message) {
 			p.Fail("Cannot include", fieldName, "field into", ormable.Name, "as it aready exists there.")
 		}
 	}
+	if isSoftDelete(msg) {
+		p.parseSoftDelete(ormable)
+	}
 }
 
 func tagWithType(tag *gorm.GormTag, typename string) *gorm.GormTag {
@@ -376,7 +469,7 @@ func (p *OrmPlugin) addIncludedField(ormable *OrmableType, field *gorm.ExtraFiel
 		} else if rawType == "Time" {
 			f.GoIdent = identTime
 		} else if rawType == "UUID" {
-			f.GoIdent = identUUID
+			f.GoIdent = p.uuid.UUID
 		} else if field.GetType() == "Jsonb" || field.GetType() == "JSON" {
 			f.GoIdent = identGormJSON
 		} else if rawType == "Inet" {
@@ -430,14 +523,29 @@ func (p *OrmPlugin) generateTableNameFunction(message *protogen.Message) {
 
 	p.P(`// TableName overrides the default table name generated by GORM`)
 	p.P(`func (`, typeName, `ORM) TableName() string {`)
+	p.P(`return "`, tableName(message), `"`)
+	p.P(`}`)
+}
 
-	ns := schema.NamingStrategy{}
-	tableName := ns.TableName(typeName)
+// tableName is the table of the ormable message, named by the settings of
+// its file unless its table option is qualified by a schema
+func tableName(message *protogen.Message) string {
+	fileOpts := getFileOptions(message.Desc.ParentFile())
 	if opts := getMessageOptions(message); opts != nil && opts.Table != nil {
-		tableName = opts.GetTable()
+		if strings.Contains(opts.GetTable(), ".") {
+			return opts.GetTable()
+		}
+		return qualifiedTableName(fileOpts, fileOpts.GetTablePrefix()+opts.GetTable())
 	}
-	p.P(`return "`, tableName, `"`)
-	p.P(`}`)
+	return qualifiedTableName(fileOpts, fileNaming(fileOpts).TableName(messageName(message)))
+}
+
+// qualifiedTableName qualifies the table by the schema of the file
+func qualifiedTableName(fileOpts *gorm.GormFileOptions, table string) string {
+	if schema := fileOpts.GetSchema(); schema != "" {
+		return schema + "." + table
+	}
+	return table
 }
 
 // generateMapFunctions creates the converter functions
@@ -456,13 +564,23 @@ func (p *OrmPlugin) generateConvertFunctions(message *protogen.Message) {
 	p.P(`}`)
 	p.P(`}`)
 	for _, field := range message.Fields {
+		if isFirstOneofField(field) {
+			p.generateOneofConversion(message, field.Oneof, true)
+		}
 		// Checking if field is skipped
-		if getFieldOptions(field).GetDrop() {
+		if getFieldOptions(field).GetDrop() || isOneofField(field) {
+			continue
+		}
+		if p.isEmbeddedMessage(field) {
+			p.generateEmbeddedConversion(field, true)
 			continue
 		}
 		fname := field.GoName
 
 		ofield := ormable.Fields[fname]
+		if ofield != nil && p.generateCustomConversion(field, true) {
+			continue
+		}
 		p.generateFieldConversion(message, field, true, ofield)
 	}
 	if getMessageOptions(message).GetMultiAccount() {
@@ -493,11 +611,25 @@ func (p *OrmPlugin) generateConvertFunctions(message *protogen.Message) {
 	p.P(`}`)
 	p.P(`}`)
 	for _, field := range message.Fields {
+		if isFirstOneofField(field) {
+			p.generateOneofConversion(message, field.Oneof, false)
+		}
 		// Checking if field is skipped
-		if getFieldOptions(field).GetDrop() {
+		if getFieldOptions(field).GetDrop() || isOneofField(field) {
+			continue
+		}
+		if p.isEmbeddedMessage(field) {
+			p.generateEmbeddedConversion(field, false)
+			continue
+		}
+		// write-only fields never leave the server
+		if isWriteOnlyField(field) {
 			continue
 		}
 		ofield := ormable.Fields[field.GoName]
+		if ofield != nil && p.generateCustomConversion(field, false) {
+			continue
+		}
 		p.generateFieldConversion(message, field, false, ofield)
 	}
 	p.P(`if posthook, ok := interface{}(m).(`, typeName, `WithAfterToPB); ok {`)
@@ -514,14 +646,34 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 	fieldType := p.fieldType(field)
 	ident := fieldIdent(field)
 
-	if desc.IsList() { // Repeated Object ----------------------------------
+	if desc.IsMap() { // Map stored as JSON ---------------------------------
+		p.generateMapConversion(field, toORM)
+	} else if desc.IsList() { // Repeated Object ----------------------------------
 		// Some repeated fields can be handled by github.com/lib/pq
-		if p.IsAbleToMakePQArray(fieldType) {
+		if p.isJSONArray(field) {
+			p.generateJSONArrayConversion(field, toORM)
+		} else if p.IsAbleToMakePQArray(fieldType) {
 			pqIdent, _, _ := p.fieldToPQArrayIdent(field)
 			p.P(`if m.`, fieldName, ` != nil {`)
 			p.P(`to.`, fieldName, ` = make(`, pqIdent, `, len(m.`, fieldName, `))`)
 			p.P(`copy(to.`, fieldName, `, m.`, fieldName, `)`)
 			p.P(`}`)
+		} else if desc.Enum() != nil { // Repeated Enum, stored in a PQ array
+			p.P(`if m.`, fieldName, ` != nil {`)
+			if toORM {
+				pqIdent, _ := p.enumToPQArrayIdent(field)
+				p.P(`to.`, fieldName, ` = make(`, pqIdent, `, len(m.`, fieldName, `))`)
+			} else {
+				p.P(`to.`, fieldName, ` = make([]`, ident, `, len(m.`, fieldName, `))`)
+			}
+			p.P(`for i, v := range m.`, fieldName, ` {`)
+			if toORM {
+				p.P(append([]interface{}{`to.`, fieldName, `[i] = `}, p.enumToORM(field, "v")...)...)
+			} else {
+				p.P(append([]interface{}{`to.`, fieldName, `[i] = `}, p.enumToPB(field, "v", "value")...)...)
+			}
+			p.P(`}`)
+			p.P(`}`)
 		} else if p.isOrmable(fieldType) { // Repeated ORMable type
 			//fieldType = strings.Trim(fieldType, "[]*")
 
@@ -540,30 +692,45 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 			p.P(`to.`, fieldName, ` = append(to.`, fieldName, `, nil)`)
 			p.P(`}`)
 			p.P(`}`) // end repeated for
+		} else if p.isJSONList(field) {
+			p.generateJSONListConversion(field, toORM)
 		} else {
 			p.P(`// Repeated type `, fieldType, ` is not an ORMable message type`)
 			p.warning("repeated type %s was not ormable for desc %v", fieldType, desc)
 		}
+	} else if desc.Enum() != nil && isOptionalScalar(field) { // Optional Enum
+		p.P(`if m.`, fieldName, ` != nil {`)
+		if toORM {
+			p.P(append([]interface{}{`v := `}, p.enumToORM(field, "*m."+fieldName)...)...)
+		} else {
+			p.P(append([]interface{}{`v := `}, p.enumToPB(field, "*m."+fieldName, "value")...)...)
+		}
+		p.P(`to.`, fieldName, ` = &v`)
+		p.P(`}`)
 	} else if desc.Enum() != nil { // Singular Enum, which is an int32 ---
 		if toORM {
-			if p.StringEnums {
-				p.P(`to.`, fieldName, ` = `, ident, `_name[int32(m.`, fieldName, `)]`)
-			} else {
-				p.P(`to.`, fieldName, ` = int32(m.`, fieldName, `)`)
-			}
+			p.P(append([]interface{}{`to.`, fieldName, ` = `}, p.enumToORM(field, "m."+fieldName)...)...)
 		} else {
-			if p.StringEnums {
-				p.P(`to.`, fieldName, ` = `, ident, `(`, ident, `_value[m.`, fieldName, `])`)
-			} else {
-				p.P(`to.`, fieldName, ` = `, ident, `(m.`, fieldName, `)`)
-			}
+			p.P(append([]interface{}{`to.`, fieldName, ` = `}, p.enumToPB(field, "m."+fieldName, "temp"+fieldName)...)...)
 		}
 	} else if desc.Message() != nil { // Singular Object -------------
 		//Check for WKTs
 		parts := strings.Split(fieldType, ".")
 		coreType := parts[len(parts)-1]
 		// Type is a WKT, convert to/from as ptr to base type
-		if _, exists := wellKnownTypes[coreType]; exists { // Singular WKT -----
+		if mapping, ok := p.typeMapping(field); ok { // User-defined type mapping
+			p.generateTypeMappingConversion(field, mapping, toORM)
+		} else if isBytesValue(field) { // Singular BytesValue, as nullable []byte
+			if toORM {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`to.`, fieldName, ` = append([]byte{}, m.`, fieldName, `.Value...)`)
+				p.P(`}`)
+			} else {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`to.`, fieldName, ` = &`, ident, `{Value: m.`, fieldName, `}`)
+				p.P(`}`)
+			}
+		} else if _, exists := wellKnownTypes[coreType]; exists { // Singular WKT -----
 			if toORM {
 				p.P(`if m.`, fieldName, ` != nil {`)
 				p.P(`v := m.`, fieldName, `.Value`)
@@ -578,7 +745,7 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 		} else if coreType == protoTypeUUIDValue { // Singular UUIDValue type ----
 			if toORM {
 				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`tempUUID, uErr := `, p.identFnCall(identUUIDFromStringFn, fmt.Sprintf("m.%s.Value", fieldName)))
+				p.P(`tempUUID, uErr := `, p.identFnCall(p.uuid.ParseFn, fmt.Sprintf("m.%s.Value", fieldName)))
 				p.P(`if uErr != nil {`)
 				p.P(`return to, uErr`)
 				p.P(`}`)
@@ -592,12 +759,12 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 		} else if coreType == protoTypeUUID { // Singular UUID type --------------
 			if toORM {
 				p.P(`if m.`, fieldName, ` != nil {`)
-				p.P(`to.`, fieldName, `, err = `, p.identFnCall(identUUIDFromStringFn, fmt.Sprintf("m.%s.Value", fieldName)))
+				p.P(`to.`, fieldName, `, err = `, p.identFnCall(p.uuid.ParseFn, fmt.Sprintf("m.%s.Value", fieldName)))
 				p.P(`if err != nil {`)
 				p.P(`return to, err`)
 				p.P(`}`)
 				p.P(`} else {`)
-				p.P(`to.`, fieldName, ` = `, identNilUUID)
+				p.P(`to.`, fieldName, ` = `, p.uuid.Nil)
 				p.P(`}`)
 			} else {
 				p.P(`to.`, fieldName, ` = &`, identTypesUUID, `{Value: m.`, fieldName, `.String()}`)
@@ -609,25 +776,85 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 				p.P(`return to, fmt.Errorf("`, fieldName, ` invalid")`)
 				p.P(`}`)
 				p.P(`t := m.`, fieldName, `.AsTime()`)
-				if fieldName == "DeletedAt" {
-					p.P(`to.`, fieldName, ` = gorm.DeletedAt{Time: t}`)
+				if isSoftDeleteField(ofield) {
+					p.P(`to.`, fieldName, ` = `, identGormDeletedAt, `{Time: t, Valid: true}`)
 				} else {
 					p.P(`to.`, fieldName, ` = &t`)
 				}
 				p.P(`}`)
 			} else {
-				if fieldName == "DeletedAt" {
+				if isSoftDeleteField(ofield) {
 					p.P(`if m.`, fieldName, `.Valid {`)
-					p.P(`to.`, fieldName, ` = timestamppb.New(m.`, fieldName, `.Time)`)
+					p.P(`to.`, fieldName, ` = `, identTimestampNewFn, `(m.`, fieldName, `.Time)`)
 				} else {
 					p.P(`if m.`, fieldName, ` != nil {`)
-					p.P(`to.`, fieldName, ` = timestamppb.New(*m.` , fieldName, `)`)
+					p.P(`to.`, fieldName, ` = `, identTimestampNewFn, `(*m.`, fieldName, `)`)
 					//p.P(`if to.`, fieldName, `, err = `, identTimestampProto, `(*m.`, fieldName, `); err != nil {`)
 					//p.P(`return to, err`)
 					//p.P(`}`)
 				}
 				p.P(`}`)
 			}
+		} else if isStructMessage(desc.Message()) { // Struct, Value and ListValue
+			if toORM {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`if to.`, fieldName, `, err = `, identProtojsonMarshalFn, `(m.`, fieldName, `); err != nil {`)
+				p.P(`return to, err`)
+				p.P(`}`)
+				p.P(`}`)
+			} else {
+				p.P(`if len(m.`, fieldName, `) > 0 {`)
+				p.P(`to.`, fieldName, ` = &`, ident, `{}`)
+				p.P(`if err = `, identProtojsonUnmarshalFn, `(m.`, fieldName, `, to.`, fieldName, `); err != nil {`)
+				p.P(`return to, err`)
+				p.P(`}`)
+				p.P(`}`)
+			}
+		} else if isDecimalMessage(desc.Message()) { // Singular Decimal, exact numeric
+			if toORM {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`if to.`, fieldName, `, err = `, identTypesParseNumericFn, `(m.`, fieldName, `.Value); err != nil {`)
+				p.P(`return to, err`)
+				p.P(`}`)
+				p.P(`}`)
+			} else {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`to.`, fieldName, ` = &`, ident, `{Value: m.`, fieldName, `.String()}`)
+				p.P(`}`)
+			}
+		} else if isDateMessage(desc.Message()) { // Singular Date ----------------
+			if toORM {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`to.`, fieldName, ` = &`, identTypesDate, `{Year: m.`, fieldName, `.Year, Month: m.`, fieldName, `.Month, Day: m.`, fieldName, `.Day}`)
+				p.P(`}`)
+			} else {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`to.`, fieldName, ` = &`, ident, `{Year: m.`, fieldName, `.Year, Month: m.`, fieldName, `.Month, Day: m.`, fieldName, `.Day}`)
+				p.P(`}`)
+			}
+		} else if coreType == protoTypeDuration { // Singular WKT Duration ---
+			isInterval := ofield.F.GoIdent.GoName == "*"+identTypesInterval.GoName
+			if toORM {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				p.P(`if !m.`, fieldName, `.IsValid() {`)
+				p.P(`return to, `, identFmtErrorf, `("`, fieldName, ` invalid")`)
+				p.P(`}`)
+				if isInterval {
+					p.P(`d := `, identTypesInterval, `(m.`, fieldName, `.AsDuration())`)
+				} else {
+					p.P(`d := m.`, fieldName, `.AsDuration()`)
+				}
+				p.P(`to.`, fieldName, ` = &d`)
+				p.P(`}`)
+			} else {
+				p.P(`if m.`, fieldName, ` != nil {`)
+				if isInterval {
+					p.P(`to.`, fieldName, ` = `, identDurationNewFn, `(`, identTimeDuration, `(*m.`, fieldName, `))`)
+				} else {
+					p.P(`to.`, fieldName, ` = `, identDurationNewFn, `(*m.`, fieldName, `)`)
+				}
+				p.P(`}`)
+			}
 		} else if coreType == protoTypeJSON {
 			if toORM {
 				p.P(`if m.`, fieldName, ` != nil {`)
@@ -745,6 +972,11 @@ func (p *OrmPlugin) generateFieldConversion(message *protogen.Message, field *pr
 			p.P(`to.`, fieldName, ` = &temp`, fieldName)
 			p.P(`}`)
 		}
+	} else if isOptionalScalar(field) && desc.Kind() != protoreflect.BytesKind { // Optional raw
+		p.P(`if m.`, fieldName, ` != nil {`)
+		p.P(`v := *m.`, fieldName)
+		p.P(`to.`, fieldName, ` = &v`)
+		p.P(`}`)
 	} else { // Singular raw ----------------------------------------------------
 		p.P(`to.`, fieldName, ` = m.`, fieldName)
 	}
@@ -0,0 +1,410 @@
+package plugin
+
+import (
+	"errors"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/kirinse/protoc-gen-gorm/example/features"
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "Updates the generated code of the examples if true.")
+
+// featureProtos are the example protos generated for SQLite with the default
+// handlers, their generated code is compiled and run by the example tests
+var featureProtos = []string{
+	"example/features/accounts.proto",
+	"example/features/checks.proto",
+	"example/features/composite.proto",
+	"example/features/dates.proto",
+	"example/features/durations.proto",
+	"example/features/embedded.proto",
+	"example/features/enums.proto",
+	"example/features/hosts.proto",
+	"example/features/indexes.proto",
+	"example/features/json_lists.proto",
+	"example/features/locations.proto",
+	"example/features/maps.proto",
+	"example/features/nested.proto",
+	"example/features/oneofs.proto",
+	"example/features/optionals.proto",
+	"example/features/permissions.proto",
+	"example/features/prices.proto",
+	"example/features/repeated_enums.proto",
+	"example/features/soft_delete.proto",
+	"example/features/structs.proto",
+	"example/features/timestamps.proto",
+	"example/features/uuids.proto",
+	"example/features/version.proto",
+}
+
+// generate runs the plugin like protoc does on proto files linked in the test
+// binary, it returns the generated code by file name
+func generate(t *testing.T, plugin *OrmPlugin, paths ...string) map[string]string {
+	t.Helper()
+	res, err := run(plugin, request(t, paths...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// request is the code generator request of proto files linked in the test
+// binary, their imports included
+func request(t *testing.T, paths ...string) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+	var protoFiles []*descriptorpb.FileDescriptorProto
+	seen := map[string]bool{}
+	var addFile func(file protoreflect.FileDescriptor)
+	addFile = func(file protoreflect.FileDescriptor) {
+		path := file.Path()
+		if seen[path] {
+			return
+		}
+		seen[path] = true
+		if file.IsPlaceholder() {
+			file = findImport(t, path)
+		}
+		for i := 0; i < file.Imports().Len(); i++ {
+			addFile(file.Imports().Get(i).FileDescriptor)
+		}
+		protoFile := protodesc.ToFileDescriptorProto(file)
+		protoFile.Name = &path
+		protoFiles = append(protoFiles, protoFile)
+	}
+	for _, path := range paths {
+		file, err := protoregistry.GlobalFiles.FindFileByPath(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addFile(file)
+	}
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: paths,
+		ProtoFile:      protoFiles,
+	}
+}
+
+// run runs the plugin on the request, the failures of the plugin are returned
+// as errors like protoc reports them
+func run(plugin *OrmPlugin, req *pluginpb.CodeGeneratorRequest) (res map[string]string, err error) {
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(error)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, e
+		}
+	}()
+	plugin.Init(gen)
+	plugin.Generate()
+	resp := gen.Response()
+	if resp.Error != nil {
+		return nil, errors.New(resp.GetError())
+	}
+	res = make(map[string]string)
+	for _, file := range resp.File {
+		res[filepath.Base(file.GetName())] = file.GetContent()
+	}
+	return res, nil
+}
+
+// findImport finds the file of an import the linked code registered under a
+// shorter path, like the options registered as gorm.proto
+func findImport(t *testing.T, path string) protoreflect.FileDescriptor {
+	t.Helper()
+	for name := path; name != ""; {
+		if file, err := protoregistry.GlobalFiles.FindFileByPath(name); err == nil {
+			return file
+		}
+		i := strings.IndexByte(name, '/')
+		if i < 0 {
+			break
+		}
+		name = name[i+1:]
+	}
+	t.Fatalf("Import %s is not linked", path)
+	return nil
+}
+
+func generateFeatures(t *testing.T) map[string]string {
+	t.Helper()
+	return generate(t, &OrmPlugin{SuppressWarnings: true, DefaultHandlers: true, Dialect: "sqlite"}, featureProtos...)
+}
+
+func TestGenerateFeatures(t *testing.T) {
+	files := generateFeatures(t)
+	if len(files) != len(featureProtos) {
+		t.Fatalf("Expected %d generated files, got %d", len(featureProtos), len(files))
+	}
+	for name, content := range files {
+		path := filepath.Join("..", "example", "features", name)
+		if *update {
+			if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		expected, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(expected) != content {
+			t.Errorf("Generated code of %s differs from %s, run go test ./plugin -update", name, path)
+		}
+	}
+}
+
+// fieldTag returns the tag of the field of the ORM struct of the message
+func fieldTag(t *testing.T, content, message, field string) string {
+	t.Helper()
+	start := strings.Index(content, "type "+message+"ORM struct {")
+	if start < 0 {
+		t.Fatalf("No ORM struct of %s", message)
+	}
+	body := content[start : start+strings.Index(content[start:], "\n}\n")]
+	for _, line := range strings.Split(body, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == field {
+			if i := strings.IndexByte(line, '`'); i >= 0 {
+				return strings.Trim(line[i:], "`")
+			}
+			return ""
+		}
+	}
+	t.Fatalf("No field %s in the ORM struct of %s", field, message)
+	return ""
+}
+
+func TestAutoTimeTags(t *testing.T) {
+	content := generateFeatures(t)["timestamps.pb.gorm.go"]
+	cases := []struct {
+		message string
+		field   string
+		tag     string
+	}{
+		{"Stamp", "CreatedAt", `gorm:"precision:3;autoCreateTime"`},
+		{"Stamp", "SeenAt", `gorm:"precision:6;autoUpdateTime"`},
+		{"Stamp", "UpdatedAt", `gorm:"autoUpdateTime:milli"`},
+		{"Stamp", "Born", `gorm:"autoCreateTime"`},
+		{"Clock", "Started", `gorm:"autoCreateTime"`},
+	}
+	for _, v := range cases {
+		if tag := fieldTag(t, content, v.message, v.field); tag != v.tag {
+			t.Errorf("Expected tag of %s.%s: %s, got %s", v.message, v.field, v.tag, tag)
+		}
+	}
+}
+
+// compositeHasMany is the request of composite.proto with a has_many of Grant
+// added to Slot, Grant is matched by both fields of the key of Slot
+func compositeHasMany(t *testing.T) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+	req := request(t, "example/features/composite.proto")
+	file := req.ProtoFile[len(req.ProtoFile)-1]
+	options := &descriptorpb.MessageOptions{}
+	proto.SetExtension(options, gorm.E_Opts, &gorm.GormMessageOptions{Ormable: proto.Bool(true)})
+	file.MessageType = append(file.MessageType, &descriptorpb.DescriptorProto{
+		Name: proto.String("Grant"),
+		Field: []*descriptorpb.FieldDescriptorProto{{
+			Name:     proto.String("id"),
+			JsonName: proto.String("id"),
+			Number:   proto.Int32(1),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		}},
+		Options: options,
+	})
+	slot := file.MessageType[0]
+	slot.Field = append(slot.Field, &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("grants"),
+		JsonName: proto.String("grants"),
+		Number:   proto.Int32(4),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: proto.String(".features.Grant"),
+	})
+	return req
+}
+
+func TestCompositeKeyAssociations(t *testing.T) {
+	_, err := run(&OrmPlugin{SuppressWarnings: true, DefaultHandlers: true, Dialect: "sqlite"}, compositeHasMany(t))
+	if err == nil || !strings.Contains(err.Error(), "composite key TenantId, Position") {
+		t.Errorf("Expected the composite key has_many to be rejected on sqlite, got %v", err)
+	}
+	files, err := run(&OrmPlugin{SuppressWarnings: true, DefaultHandlers: true, Dialect: "postgres"}, compositeHasMany(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag := fieldTag(t, files["composite.pb.gorm.go"], "Slot", "Grants"); !strings.Contains(tag, "foreignKey:SlotTenantId,SlotPosition") {
+		t.Errorf("Unexpected tag of Slot.Grants on postgres: %s", tag)
+	}
+}
+
+func TestDecimalColumnTypes(t *testing.T) {
+	for dialect, tag := range map[string]string{
+		"postgres": `gorm:"type:numeric(10,4);precision:10;scale:4"`,
+		"mysql":    `gorm:"type:numeric(10,4);precision:10;scale:4"`,
+		"sqlite":   `gorm:"type:text;precision:10;scale:4"`,
+	} {
+		files := generate(t, &OrmPlugin{SuppressWarnings: true, Dialect: dialect}, "example/features/prices.proto")
+		if res := fieldTag(t, files["prices.pb.gorm.go"], "Price", "Rate"); res != tag {
+			t.Errorf("Expected tag of Price.Rate on %s: %s, got %s", dialect, tag, res)
+		}
+	}
+}
+
+func TestNativeEnumSchema(t *testing.T) {
+	req := request(t, "example/features/enums.proto")
+	file := req.ProtoFile[len(req.ProtoFile)-1]
+	fileOpts := proto.Clone(proto.GetExtension(file.Options, gorm.E_FileOpts).(*gorm.GormFileOptions)).(*gorm.GormFileOptions)
+	fileOpts.Schema = proto.String("billing")
+	proto.SetExtension(file.Options, gorm.E_FileOpts, fileOpts)
+	files, err := run(&OrmPlugin{SuppressWarnings: true, Dialect: "postgres"}, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := files["enums.pb.gorm.go"]
+	if tag := fieldTag(t, content, "Ticket", "Native"); tag != `gorm:"type:billing.status"` {
+		t.Errorf("Expected the native enum column in the billing schema, got %s", tag)
+	}
+	if !strings.Contains(content, "const StatusEnumType = `CREATE TYPE billing.status AS ENUM (") {
+		t.Errorf("Expected the native enum type created in the billing schema")
+	}
+}
+
+func TestAutoTimePrecisions(t *testing.T) {
+	cases := []struct {
+		field     string
+		precision string
+		err       string
+	}{
+		{"seen_at", "nano", "expected sec, milli or micro"},
+		{"updated_at", "micro", "expected sec, milli or nano"},
+	}
+	for _, v := range cases {
+		req := request(t, "example/features/timestamps.proto")
+		file := req.ProtoFile[len(req.ProtoFile)-1]
+		for _, field := range file.MessageType[0].Field {
+			if field.GetName() != v.field {
+				continue
+			}
+			if field.Options == nil {
+				field.Options = &descriptorpb.FieldOptions{}
+			}
+			fieldOpts, _ := proto.GetExtension(field.Options, gorm.E_Field).(*gorm.GormFieldOptions)
+			if fieldOpts == nil {
+				fieldOpts = &gorm.GormFieldOptions{}
+			}
+			fieldOpts = proto.Clone(fieldOpts).(*gorm.GormFieldOptions)
+			fieldOpts.Tag = &gorm.GormTag{AutoUpdateTime: proto.String(v.precision)}
+			proto.SetExtension(field.Options, gorm.E_Field, fieldOpts)
+		}
+		_, err := run(&OrmPlugin{SuppressWarnings: true}, req)
+		if err == nil || !strings.Contains(err.Error(), v.err) {
+			t.Errorf("Expected %s precision of %s to fail with %q, got %v", v.precision, v.field, v.err, err)
+		}
+	}
+}
+
+func TestCheckColumns(t *testing.T) {
+	for _, expression := range []string{"guests < 100", "'start_at' <> 'end_at'"} {
+		req := request(t, "example/features/checks.proto")
+		message := req.ProtoFile[len(req.ProtoFile)-1].MessageType[0]
+		opts := proto.Clone(proto.GetExtension(message.Options, gorm.E_Opts).(*gorm.GormMessageOptions)).(*gorm.GormMessageOptions)
+		opts.Checks = append(opts.Checks, &gorm.GormCheck{Name: proto.String("chk_extra"), Expression: proto.String(expression)})
+		proto.SetExtension(message.Options, gorm.E_Opts, opts)
+		_, err := run(&OrmPlugin{SuppressWarnings: true}, req)
+		if err == nil || !strings.Contains(err.Error(), "references no column without a check") {
+			t.Errorf("Expected check %q to be rejected, got %v", expression, err)
+		}
+	}
+}
+
+func TestRepeatedEnumArrays(t *testing.T) {
+	files := generate(t, &OrmPlugin{SuppressWarnings: true, Dialect: "postgres"}, "example/features/repeated_enums.proto")
+	content := files["repeated_enums.pb.gorm.go"]
+	for field, tag := range map[string]string{"Days": `gorm:"type:integer[]"`, "DayNames": `gorm:"type:text[]"`} {
+		if res := fieldTag(t, content, "Schedule", field); res != tag {
+			t.Errorf("Expected tag of Schedule.%s on postgres: %s, got %s", field, tag, res)
+		}
+	}
+	for _, decl := range []string{"Days     pq.Int32Array", "DayNames pq.StringArray"} {
+		if !strings.Contains(content, decl) {
+			t.Errorf("Expected the ORM field %s on postgres", decl)
+		}
+	}
+}
+
+func TestDurationIntervals(t *testing.T) {
+	files := generate(t, &OrmPlugin{SuppressWarnings: true, Dialect: "postgres"}, "example/features/durations.proto")
+	content := files["durations.pb.gorm.go"]
+	if tag := fieldTag(t, content, "Retention", "Window"); tag != `gorm:"type:interval"` {
+		t.Errorf("Expected the interval column of Retention.Window on postgres, got %s", tag)
+	}
+	for _, decl := range []string{"Ttl    *time.Duration", "Window *types.Interval"} {
+		if !strings.Contains(content, decl) {
+			t.Errorf("Expected the ORM field %s on postgres", decl)
+		}
+	}
+}
+
+func TestUUIDLibraries(t *testing.T) {
+	files := generate(t, &OrmPlugin{SuppressWarnings: true, UUIDLibrary: "gofrs"}, "example/features/uuids.proto")
+	if content := files["uuids.pb.gorm.go"]; !strings.Contains(content, `uuid "github.com/google/uuid"`) {
+		t.Errorf("Expected the uuid_library file option to override the uuid parameter")
+	}
+	for library, call := range map[string]string{"gofrs": "uuid.FromString(m.Id.Value)", "satori": "uuid.FromString(m.Id.Value)", "google": "uuid.Parse(m.Id.Value)"} {
+		req := request(t, "example/features/uuids.proto")
+		file := req.ProtoFile[len(req.ProtoFile)-1]
+		proto.SetExtension(file.Options, gorm.E_FileOpts, &gorm.GormFileOptions{})
+		files, err := run(&OrmPlugin{SuppressWarnings: true, UUIDLibrary: library}, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content := files["uuids.pb.gorm.go"]
+		if !strings.Contains(content, `uuid "`+string(uuidLibraries[library].UUID.GoImportPath)+`"`) || !strings.Contains(content, call) {
+			t.Errorf("Expected the %s UUID library imported and called with %s", library, call)
+		}
+	}
+}
+
+func TestDialects(t *testing.T) {
+	cases := map[string]struct {
+		aliases string
+		address string
+		locked  bool
+	}{
+		"postgres": {"Aliases pq.StringArray `gorm:\"type:text[]\"`", "Address *types.Inet    `gorm:\"type:inet\"`", true},
+		"mysql":    {"Aliases datatypes.JSON", "Address *types.Inet `gorm:\"type:varchar(43)\"`", true},
+		"sqlite":   {"Aliases datatypes.JSON", "Address *types.Inet `gorm:\"type:text\"`", false},
+	}
+	for dialect, v := range cases {
+		files := generate(t, &OrmPlugin{SuppressWarnings: true, DefaultHandlers: true, Dialect: dialect}, "example/features/hosts.proto")
+		content := files["hosts.pb.gorm.go"]
+		for _, decl := range []string{v.aliases, v.address} {
+			if !strings.Contains(content, decl) {
+				t.Errorf("Expected the ORM field %s on %s", decl, dialect)
+			}
+		}
+		if locked := strings.Contains(content, `Clauses(clause.Locking{Strength: "UPDATE"})`); locked != v.locked {
+			t.Errorf("Expected the row locked by the strict update on %s: %v, got %v", dialect, v.locked, locked)
+		}
+	}
+}
@@ -15,6 +15,8 @@ const (
 	deleteService    = "Delete"
 	deleteSetService = "DeleteSet"
 	listService      = "List"
+	undeleteService  = "Undelete"
+	purgeService     = "Purge"
 )
 
 type autogenService struct {
@@ -77,6 +79,12 @@ func (p *OrmPlugin) parseServices(file *protogen.File) {
 			} else if strings.HasPrefix(methodName, listService) {
 				verb = listService
 				follows, baseType = p.followsListConventions(inType, outType, listService)
+			} else if strings.HasPrefix(methodName, undeleteService) {
+				verb = undeleteService
+				follows, baseType = p.followsUndeleteConventions(inType, outType, undeleteService)
+			} else if strings.HasPrefix(methodName, purgeService) {
+				verb = purgeService
+				follows, baseType = p.followsPurgeConventions(inType, outType, method)
 			}
 			genMethod := autogenMethod{
 				Method:            method,
@@ -121,13 +129,13 @@ func (p *OrmPlugin) generateDefaultServer(file *protogen.File) {
 			switch method.verb {
 			case createService:
 				p.generateCreateServerMethod(service, method)
-			case readService:
+			case readService, undeleteService:
 				p.generateReadServerMethod(service, method)
 			case updateService:
 				p.generateUpdateServerMethod(service, method)
 			case updateSetService:
 				p.generateUpdateSetServerMethod(service, method)
-			case deleteService:
+			case deleteService, purgeService:
 				p.generateDeleteServerMethod(service, method)
 			case deleteSetService:
 				p.generateDeleteSetServerMethod(service, method)
@@ -206,8 +214,8 @@ func (p *OrmPlugin) generateCreateServerMethod(service autogenService, method au
 		p.spanResultHandling(service)
 		p.P(`return out, nil`)
 		p.P(`}`)
-		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
-		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName, defaultHandlerName(method, method.baseType))
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName, defaultHandlerName(method, method.baseType))
 	} else {
 		p.generateEmptyBody(service, method.outType)
 	}
@@ -219,10 +227,12 @@ func (p *OrmPlugin) generateReadServerMethod(service autogenService, method auto
 		p.generateDBSetup(service)
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
 		typeName := method.baseType
-		if fields := p.getFieldSelection(method.inType); fields != "" {
-			p.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, in.`, fields, `)`)
+		if method.verb == undeleteService {
+			p.P(`res, err := DefaultRestore`, typeName, `(ctx, `, p.keyLiteral(typeName, `in.GetId()`), `, db)`)
+		} else if fields := p.getFieldSelection(method.inType); fields != "" {
+			p.P(`res, err := DefaultRead`, typeName, `(ctx, `, p.keyLiteral(typeName, `in.GetId()`), `, db, in.`, fields, `)`)
 		} else {
-			p.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+			p.P(`res, err := DefaultRead`, typeName, `(ctx, `, p.keyLiteral(typeName, `in.GetId()`), `, db)`)
 		}
 		p.P(`if err != nil {`)
 		p.P(`return nil, `, p.wrapSpanError(service, "err"))
@@ -232,8 +242,8 @@ func (p *OrmPlugin) generateReadServerMethod(service autogenService, method auto
 		p.spanResultHandling(service)
 		p.P(`return out, nil`)
 		p.P(`}`)
-		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
-		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName, defaultHandlerName(method, method.baseType))
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName, defaultHandlerName(method, method.baseType))
 	} else {
 		p.generateEmptyBody(service, method.outType)
 	}
@@ -315,6 +325,14 @@ func (p *OrmPlugin) followsReadConventions(inType *protogen.Message, outType *pr
 		p.warning(`stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, out)
 		return false, ""
 	}
+	if p.hasCompositePrimaryKey(p.getOrmable(outFieldType)) {
+		p.warning(`stub will be generated for %s since %s ormable type has a composite primary key`, methodName, outFieldType)
+		return false, ""
+	}
+	if !p.hasKeyField(p.getOrmable(outFieldType)) {
+		p.warning(`stub will be generated for %s since the primary key of %s is not a field of the message`, methodName, outFieldType)
+		return false, ""
+	}
 	return true, outFieldType
 }
 
@@ -380,6 +398,7 @@ func (p *OrmPlugin) generateUpdateServerMethod(service autogenService, method au
 			p.P(`res, err = DefaultStrictUpdate`, typeName, `(ctx, in.GetPayload(), db)`)
 		}
 		p.P(`if err != nil {`)
+		p.generateVersionConflictStatus(typeName)
 		p.P(`return nil, `, p.wrapSpanError(service, "err"))
 		p.P(`}`)
 		p.P(`out := &`, method.outType.GoIdent.GoName, `{Result: res}`)
@@ -387,8 +406,8 @@ func (p *OrmPlugin) generateUpdateServerMethod(service autogenService, method au
 		p.spanResultHandling(service)
 		p.P(`return out, nil`)
 		p.P(`}`)
-		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
-		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName, defaultHandlerName(method, method.baseType))
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName, defaultHandlerName(method, method.baseType))
 	} else {
 		p.generateEmptyBody(service, method.outType)
 	}
@@ -410,6 +429,7 @@ func (p *OrmPlugin) generateUpdateSetServerMethod(service autogenService, method
 		p.P(``)
 		p.P(`res, err := DefaultPatchSet`, typeName, `(ctx, in.GetObjects(), in.Get`, method.fieldMaskName, `(), db)`)
 		p.P(`if err != nil {`)
+		p.generateVersionConflictStatus(typeName)
 		p.P(`return nil, `, p.wrapSpanError(service, "err"))
 		p.P(`}`)
 		p.P(``)
@@ -428,8 +448,8 @@ func (p *OrmPlugin) generateUpdateSetServerMethod(service autogenService, method
 		p.P(`return out, nil`)
 		p.P(`}`)
 
-		p.generatePreserviceHook(service.ccName, typeName, method.ccName)
-		p.generatePostserviceHook(service.ccName, typeName, method.outType.GoIdent.GoName, method.ccName)
+		p.generatePreserviceHook(service.ccName, typeName, method.ccName, defaultHandlerName(method, typeName))
+		p.generatePostserviceHook(service.ccName, typeName, method.outType.GoIdent.GoName, method.ccName, defaultHandlerName(method, typeName))
 	} else {
 		p.generateEmptyBody(service, method.outType)
 	}
@@ -498,7 +518,11 @@ func (p *OrmPlugin) generateDeleteServerMethod(service autogenService, method au
 		typeName := method.baseType
 		p.generateDBSetup(service)
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
-		p.P(`err := DefaultDelete`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+		handler := `DefaultDelete`
+		if method.verb == purgeService {
+			handler = `DefaultHardDelete`
+		}
+		p.P(`err := `, handler, typeName, `(ctx, `, p.keyLiteral(typeName, `in.GetId()`), `, db)`)
 		p.P(`if err != nil {`)
 		p.P(`return nil, `, p.wrapSpanError(service, "err"))
 		p.P(`}`)
@@ -507,8 +531,8 @@ func (p *OrmPlugin) generateDeleteServerMethod(service autogenService, method au
 		p.spanResultHandling(service)
 		p.P(`return out, nil`)
 		p.P(`}`)
-		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
-		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName, defaultHandlerName(method, method.baseType))
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName, defaultHandlerName(method, method.baseType))
 	} else {
 		p.generateEmptyBody(service, method.outType)
 	}
@@ -539,9 +563,75 @@ func (p *OrmPlugin) followsDeleteConventions(inType *protogen.Message, outType *
 		p.warning(`stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, typeName)
 		return false, ""
 	}
+	if p.hasCompositePrimaryKey(p.getOrmable(typeName)) {
+		p.warning(`stub will be generated for %s since %s ormable type has a composite primary key`, methodName, typeName)
+		return false, ""
+	}
+	if !p.hasKeyField(p.getOrmable(typeName)) {
+		p.warning(`stub will be generated for %s since the primary key of %s is not a field of the message`, methodName, typeName)
+		return false, ""
+	}
 	return true, typeName
 }
 
+// hasKeyField tells if the single primary key of the ormable is a field of
+// its message, the server methods set it to the id of the request
+func (p *OrmPlugin) hasKeyField(ormable *OrmableType) bool {
+	name := p.primaryKeyNames(ormable)[0]
+	for _, field := range ormable.Message.Fields {
+		if field.GoName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// keyLiteral is the object of the type identified by the id value, set on
+// its primary key whatever the key is named
+func (p *OrmPlugin) keyLiteral(typeName, id string) string {
+	return `&` + typeName + `{` + p.primaryKeyNames(p.getOrmable(typeName))[0] + `: ` + id + `}`
+}
+
+// defaultHandlerName is the default handler called by the server method
+func defaultHandlerName(method autogenMethod, typeName string) string {
+	switch method.verb {
+	case updateService:
+		if method.fieldMaskName != "" {
+			return "DefaultStrictUpdate" + typeName + " or DefaultPatch" + typeName
+		}
+		return "DefaultStrictUpdate" + typeName
+	case updateSetService:
+		return "DefaultPatchSet" + typeName
+	case deleteSetService:
+		return "DefaultDelete" + typeName + "Set"
+	case undeleteService:
+		return "DefaultRestore" + typeName
+	case purgeService:
+		return "DefaultHardDelete" + typeName
+	}
+	return "Default" + method.verb + typeName
+}
+
+// followsUndeleteConventions are the read conventions, for a soft deleted type
+func (p *OrmPlugin) followsUndeleteConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	follows, typeName := p.followsReadConventions(inType, outType, methodName)
+	if follows && !isSoftDelete(p.getOrmable(typeName).Message) {
+		p.warning(`stub will be generated for %s since %s ormable type is not soft deleted`, methodName, typeName)
+		return false, ""
+	}
+	return follows, typeName
+}
+
+// followsPurgeConventions are the delete conventions, for a soft deleted type
+func (p *OrmPlugin) followsPurgeConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string) {
+	follows, typeName := p.followsDeleteConventions(inType, outType, method)
+	if follows && !isSoftDelete(p.getOrmable(typeName).Message) {
+		p.warning(`stub will be generated for %s since %s ormable type is not soft deleted`, method.GoName, typeName)
+		return false, ""
+	}
+	return follows, typeName
+}
+
 func (p *OrmPlugin) generateDeleteSetServerMethod(service autogenService, method autogenMethod) {
 	p.generateMethodSignature(service, method)
 	if method.followsConvention {
@@ -549,7 +639,7 @@ func (p *OrmPlugin) generateDeleteSetServerMethod(service autogenService, method
 		p.generateDBSetup(service)
 		p.P(`objs := []*`, typeName, `{}`)
 		p.P(`for _, id := range in.Ids {`)
-		p.P(`objs = append(objs, &`, typeName, `{Id: id})`)
+		p.P(`objs = append(objs, `, p.keyLiteral(typeName, `id`), `)`)
 		p.P(`}`)
 		p.generatePreserviceCall(service, method.baseType, method.ccName)
 		p.P(`err := DefaultDelete`, typeName, `Set(ctx, objs, db)`)
@@ -561,8 +651,8 @@ func (p *OrmPlugin) generateDeleteSetServerMethod(service autogenService, method
 		p.spanResultHandling(service)
 		p.P(`return out, nil`)
 		p.P(`}`)
-		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
-		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName, defaultHandlerName(method, method.baseType))
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName, defaultHandlerName(method, method.baseType))
 	} else {
 		p.generateEmptyBody(service, method.outType)
 	}
@@ -593,6 +683,14 @@ func (p *OrmPlugin) followsDeleteSetConventions(inType *protogen.Message, outTyp
 		p.warning(`stub will be generated for %s since %s ormable type doesn't have a primary key`, methodName, typeName)
 		return false, ""
 	}
+	if p.hasCompositePrimaryKey(p.getOrmable(typeName)) {
+		p.warning(`stub will be generated for %s since %s ormable type has a composite primary key`, methodName, typeName)
+		return false, ""
+	}
+	if !p.hasKeyField(p.getOrmable(typeName)) {
+		p.warning(`stub will be generated for %s since the primary key of %s is not a field of the message`, methodName, typeName)
+		return false, ""
+	}
 	return true, typeName
 }
 
@@ -634,8 +732,8 @@ func (p *OrmPlugin) generateListServerMethod(service autogenService, method auto
 		p.spanResultHandling(service)
 		p.P(`return out, nil`)
 		p.P(`}`)
-		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName)
-		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName)
+		p.generatePreserviceHook(service.ccName, method.baseType, method.ccName, defaultHandlerName(method, method.baseType))
+		p.generatePostserviceHook(service.ccName, method.baseType, method.outType.GoIdent.GoName, method.ccName, defaultHandlerName(method, method.baseType))
 	} else {
 		p.generateEmptyBody(service, method.outType)
 	}
@@ -751,8 +849,8 @@ func (p *OrmPlugin) generatePagedRequestHandling(pg string) {
 	p.P(`}`)
 }
 
-func (p *OrmPlugin) generatePreserviceHook(svc, typeName, mthd string) {
-	p.P(`// `, svc, typeName, `WithBefore`, mthd, ` called before Default`, mthd, typeName, ` in the default `, mthd, ` handler`)
+func (p *OrmPlugin) generatePreserviceHook(svc, typeName, mthd, handler string) {
+	p.P(`// `, svc, typeName, `WithBefore`, mthd, ` called before `, handler, ` in the default `, mthd, ` handler`)
 	p.P(`type `, svc, typeName, `WithBefore`, mthd, ` interface {`)
 	p.P(`Before`, mthd, `(`, identCtx, `, *`, identGormDB, `) (*`, identGormDB, `, error)`)
 	p.P(`}`)
@@ -767,8 +865,8 @@ func (p *OrmPlugin) generatePostserviceCall(service autogenService, typeName, mt
 	p.P(`}`)
 }
 
-func (p *OrmPlugin) generatePostserviceHook(svc, typeName, outTypeName, mthd string) {
-	p.P(`// `, svc, typeName, `WithAfter`, mthd, ` called before Default`, mthd, typeName, ` in the default `, mthd, ` handler`)
+func (p *OrmPlugin) generatePostserviceHook(svc, typeName, outTypeName, mthd, handler string) {
+	p.P(`// `, svc, typeName, `WithAfter`, mthd, ` called after `, handler, ` in the default `, mthd, ` handler`)
 	p.P(`type `, svc, typeName, `WithAfter`, mthd, ` interface {`)
 	p.P(`After`, mthd, `(`, identCtx, `, *`, outTypeName, `, *`, identGormDB, `) error`)
 	p.P(`}`)
@@ -0,0 +1,139 @@
+package plugin
+
+import (
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// softDeleteField is the ORM field holding the time a soft deleted row was
+// deleted at, GORM soft deletes the rows of types with a gorm.DeletedAt field
+const softDeleteField = "DeletedAt"
+
+func isSoftDelete(message *protogen.Message) bool {
+	return getMessageOptions(message).GetSoftDelete()
+}
+
+// parseSoftDelete stores the Timestamp field named deleted_at as a
+// gorm.DeletedAt, or adds one to the ORM type when the message has none
+func (p *OrmPlugin) parseSoftDelete(ormable *OrmableType) {
+	field, ok := ormable.Fields[softDeleteField]
+	if !ok {
+		field = &Field{
+			F:                &protogen.Field{GoIdent: identGormDeletedAt},
+			Type:             identGormDeletedAt.GoName,
+			GormFieldOptions: &gorm.GormFieldOptions{},
+		}
+		ormable.Fields[softDeleteField] = field
+	} else if field.F.Desc == nil || field.F.Desc.Message() == nil || field.F.Desc.Message().FullName() != "google.protobuf.Timestamp" {
+		p.Fail("soft deleted", ormable.OriginName, "has a", softDeleteField, "field which is not a google.protobuf.Timestamp")
+	}
+	field.F.GoIdent = identGormDeletedAt
+	field.Type = identGormDeletedAt.GoName
+	if field.Tag == nil {
+		field.Tag = &gorm.GormTag{}
+	}
+	if field.Tag.Index == nil && field.Tag.UniqueIndex == nil {
+		field.Tag.Index = proto.String("")
+	}
+}
+
+// isSoftDeleteField tells if the ORM field is the gorm.DeletedAt of a soft
+// deleted type
+func isSoftDeleteField(field *Field) bool {
+	return field != nil && field.F != nil && field.F.GoIdent == identGormDeletedAt
+}
+
+// softDeleteColumn is the column of the deleted at time of a soft deleted type
+func (p *OrmPlugin) softDeleteColumn(message *protogen.Message) string {
+	ormable := p.getOrmableMessage(message)
+	return tableName(message) + "." + columnName(softDeleteField, ormable.Fields[softDeleteField])
+}
+
+// generateSoftDeleteHandlers generates the handlers restoring and deleting for
+// good the rows of a soft deleted type
+func (p *OrmPlugin) generateSoftDeleteHandlers(message *protogen.Message) {
+	if !isSoftDelete(message) {
+		return
+	}
+	p.generateRestoreHandler(message)
+	p.generateHardDeleteHandler(message)
+}
+
+func (p *OrmPlugin) generateRestoreHandler(message *protogen.Message) {
+	typeName := p.messageType(message)
+	ormable := p.getOrmable(typeName)
+	p.P(`// DefaultRestore`, typeName, ` executes a gorm update call clearing the deleted at time of a soft deleted `, typeName)
+	p.P(`func DefaultRestore`, typeName, `(ctx `, identCtx, `, in *`,
+		typeName, `, db *`, identGormDB, `) (*`, typeName, `, error) {`)
+	p.P(`if in == nil {`)
+	p.P(`return nil, `, identNilArgumentError)
+	p.P(`}`)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, "ormObj", "nil, ")
+	restore := "Restore"
+	p.generateBeforeHookCall(ormable, restore)
+	p.P(`if err = db.Unscoped().Model(&`, ormable.Name, `{}).Where(`, p.rowCondition(ormable, "ormObj"), `).Update("`, columnName(softDeleteField, ormable.Fields[softDeleteField]), `", nil).Error; err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`ormResponse := `, ormable.Name, `{}`)
+	p.P(`if err = db.Where(`, p.rowCondition(ormable, "ormObj"), `).First(&ormResponse).Error; err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.generateAfterHookCall(ormable, restore)
+	p.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
+	p.P(`return &pbResponse, err`)
+	p.P(`}`)
+	p.generateBeforeHookDef(ormable, restore)
+	p.generateAfterHookDef(ormable, restore)
+}
+
+func (p *OrmPlugin) generateHardDeleteHandler(message *protogen.Message) {
+	typeName := p.messageType(message)
+	ormable := p.getOrmable(typeName)
+	p.P(`// DefaultHardDelete`, typeName, ` executes a gorm delete call removing the `, typeName, ` for good, deleted or not`)
+	p.P(`func DefaultHardDelete`, typeName, `(ctx `, identCtx, `, in *`,
+		typeName, `, db *`, identGormDB, `) error {`)
+	p.P(`if in == nil {`)
+	p.P(`return `, identNilArgumentError)
+	p.P(`}`)
+	p.P(`ormObj, err := in.ToORM(ctx)`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.generateEmptyPrimaryKeyCheck(ormable, "ormObj", "")
+	p.P(`if hook, ok := interface{}(&ormObj).(`, ormable.Name, `WithBeforeHardDelete); ok {`)
+	p.P(`if db, err = hook.BeforeHardDelete(ctx, db); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`err = db.Unscoped().Where(`, p.rowCondition(ormable, "ormObj"), `).Delete(&`, ormable.Name, `{}).Error`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`if hook, ok := interface{}(&ormObj).(`, ormable.Name, `WithAfterHardDelete); ok {`)
+	p.P(`err = hook.AfterHardDelete(ctx, db)`)
+	p.P(`}`)
+	p.P(`return err`)
+	p.P(`}`)
+	hardDelete := "HardDelete"
+	p.generateBeforeHookDef(ormable, hardDelete)
+	p.generateAfterHookDef(ormable, hardDelete)
+}
+
+// generateDeletedFilter applies the filter of soft deleted rows set on the
+// context of the default list handler
+func (p *OrmPlugin) generateDeletedFilter(message *protogen.Message) {
+	if !isSoftDelete(message) {
+		return
+	}
+	p.P(`switch `, identTypesDeletedFilterFn, `(ctx) {`)
+	p.P(`case `, identTypesIncludeDeleted, `:`)
+	p.P(`db = db.Unscoped()`)
+	p.P(`case `, identTypesOnlyDeleted, `:`)
+	p.P(`db = db.Unscoped().Where("`, p.softDeleteColumn(message), ` IS NOT NULL")`)
+	p.P(`}`)
+}
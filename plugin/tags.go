@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/proto"
 )
 
 type tagString string
@@ -57,94 +58,97 @@ func (p *OrmPlugin) renderGormTag(field *Field) string {
 
 	gormRes.checkAndSetInt32(tag.Size, "size")
 	gormRes.checkAndSetInt32(tag.Precision, "precision")
+	gormRes.checkAndSetInt32(tag.Scale, "scale")
 
 	gormRes.checkAndSetBool(tag.PrimaryKey, "primaryKey", false)
 	gormRes.checkAndSetBool(tag.Unique, "unique", false)
 
 	gormRes.checkAndSetString(tag.Default, "default", false)
 	gormRes.checkAndSetString(tag.Comment, "comment", false)
+	check := field.Check
+	if tag.GetCheck() != "" {
+		check = tag.GetCheck()
+	}
+	if check != "" {
+		check = escapeTagValue(check)
+		gormRes.checkAndSetString(&check, "check", false)
+	}
 
 	gormRes.checkAndSetBool(tag.NotNull, "not null", false)
 	gormRes.checkAndSetBool(tag.AutoIncrement, "autoIncrement", true)
+	gormRes.checkAndSetString(tag.AutoCreateTime, "autoCreateTime", true)
+	gormRes.checkAndSetString(tag.AutoUpdateTime, "autoUpdateTime", true)
 
 	gormRes.checkAndSetString(tag.Index, "index", true)
 	gormRes.checkAndSetString(tag.UniqueIndex, "uniqueIndex", true)
+	for _, index := range field.Indexes {
+		gormRes.checkAndSetString(&index, "index", false)
+	}
 
 	gormRes.checkAndSetBool(tag.Embedded, "embedded", false)
 	gormRes.checkAndSetString(tag.EmbeddedPrefix, "embeddedPrefix", false)
 	gormRes.checkAndSetBool(tag.Ignore, "-", false)
+	switch field.GetPermission() {
+	case gorm.FieldPermission_FIELD_PERMISSION_READ_ONLY:
+		gormRes.checkAndSetString(proto.String(""), "->", true)
+	case gorm.FieldPermission_FIELD_PERMISSION_CREATE_ONLY:
+		gormRes.checkAndSetString(proto.String("create"), "<-", false)
+	}
+	if field.GetSkipMigration() {
+		gormRes.checkAndSetString(proto.String("migration"), "-", false)
+	}
 
 	if tag.GetEmbedded() {
 		return genFinalTag(gormRes, atlasRes)
 	}
 
-	var foreignKey, associationForeignKey, joinTable, joinTableForeignKey, associationJoinTableForeignKey *string
-	var associationAutoupdate, associationAutocreate, associationSaveReference, preload, replace, append, clear *bool
+	// the replace, append and clear options are no tags, they pick the association
+	// mode of the strict update handler in handleChildAssociationsByName
+	var foreignKey, references, joinTable, joinForeignKey, joinReferences, constraint *string
+	var preload *bool
 	if hasOne := field.GetHasOne(); hasOne != nil {
 		foreignKey = hasOne.Foreignkey
-		associationForeignKey = hasOne.AssociationForeignkey
-		associationAutoupdate = hasOne.AssociationAutoupdate
-		associationAutocreate = hasOne.AssociationAutocreate
-		associationSaveReference = hasOne.AssociationSaveReference
+		references = hasOne.AssociationForeignkey
+		constraint = hasOne.Constraint
 		preload = hasOne.Preload
-		clear = hasOne.Clear
-		replace = hasOne.Replace
-		append = hasOne.Append
 	} else if belongsTo := field.GetBelongsTo(); belongsTo != nil {
 		foreignKey = belongsTo.Foreignkey
-		associationForeignKey = belongsTo.AssociationForeignkey
-		associationAutoupdate = belongsTo.AssociationAutoupdate
-		associationAutocreate = belongsTo.AssociationAutocreate
-		associationSaveReference = belongsTo.AssociationSaveReference
+		references = belongsTo.AssociationForeignkey
+		constraint = belongsTo.Constraint
 		preload = belongsTo.Preload
 	} else if hasMany := field.GetHasMany(); hasMany != nil {
 		foreignKey = hasMany.Foreignkey
-		associationForeignKey = hasMany.AssociationForeignkey
-		associationAutoupdate = hasMany.AssociationAutoupdate
-		associationAutocreate = hasMany.AssociationAutocreate
-		associationSaveReference = hasMany.AssociationSaveReference
-		clear = hasMany.Clear
+		references = hasMany.AssociationForeignkey
+		constraint = hasMany.Constraint
 		preload = hasMany.Preload
-		replace = hasMany.Replace
-		append = hasMany.Append
 		atlasRes.checkAndSetString(hasMany.PositionField, "position", false)
 	} else if mtm := field.GetManyToMany(); mtm != nil {
 		foreignKey = mtm.Foreignkey
-		associationForeignKey = mtm.AssociationForeignkey
+		references = mtm.AssociationForeignkey
 		joinTable = mtm.Jointable
-		joinTableForeignKey = mtm.JointableForeignkey
-		associationJoinTableForeignKey = mtm.AssociationJointableForeignkey
-		associationAutoupdate = mtm.AssociationAutoupdate
-		associationAutocreate = mtm.AssociationAutocreate
-		associationSaveReference = mtm.AssociationSaveReference
+		joinForeignKey = mtm.JointableForeignkey
+		joinReferences = mtm.AssociationJointableForeignkey
+		constraint = mtm.Constraint
 		preload = mtm.Preload
-		clear = mtm.Clear
-		replace = mtm.Replace
-		append = mtm.Append
 	} else {
 		foreignKey = tag.Foreignkey
-		associationForeignKey = tag.AssociationForeignkey
+		references = tag.AssociationForeignkey
 		joinTable = tag.ManyToMany
-		joinTableForeignKey = tag.JointableForeignkey
-		associationJoinTableForeignKey = tag.AssociationJointableForeignkey
-		associationAutoupdate = tag.AssociationAutoupdate
-		associationAutocreate = tag.AssociationAutocreate
-		associationSaveReference = tag.AssociationSaveReference
+		joinForeignKey = tag.JointableForeignkey
+		joinReferences = tag.AssociationJointableForeignkey
+		constraint = tag.Constraint
 		preload = tag.Preload
 	}
 
-	gormRes.checkAndSetString(foreignKey, "foreignkey", false)
-	gormRes.checkAndSetString(associationForeignKey, "association_foreignkey", false)
+	gormRes.checkAndSetString(foreignKey, "foreignKey", false)
+	gormRes.checkAndSetString(references, "references", false)
 	gormRes.checkAndSetString(joinTable, "many2many", false)
-	gormRes.checkAndSetString(joinTableForeignKey, "jointable_foreignkey", false)
-	gormRes.checkAndSetString(associationJoinTableForeignKey, "association_jointable_foreignkey", false)
-	gormRes.checkAndSetBool(associationAutoupdate, "association_autoupdate", true)
-	gormRes.checkAndSetBool(associationAutocreate, "association_autocreate", true)
-	gormRes.checkAndSetBool(associationSaveReference, "association_save_reference", true)
+	gormRes.checkAndSetString(joinForeignKey, "joinForeignKey", false)
+	gormRes.checkAndSetString(joinReferences, "joinReferences", false)
+	gormRes.checkAndSetString(constraint, "constraint", false)
+	// preload is not a GORM setting, the atlas-app-toolkit collection
+	// operators read it to skip the association when preloading everything
 	gormRes.checkAndSetBool(preload, "preload", true)
-	gormRes.checkAndSetBool(clear, "clear", true)
-	gormRes.checkAndSetBool(replace, "replace", true)
-	gormRes.checkAndSetBool(append, "append", true)
 
 	finalTag := strings.TrimSpace(strings.Join([]string{gormRes.format("gorm"), atlasRes.format("atlas")}, " "))
 	if finalTag == "" {
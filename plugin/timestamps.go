@@ -0,0 +1,129 @@
+package plugin
+
+import (
+	"strings"
+
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// timePrecisions are the fractional digits of the time columns filled with
+// each precision of the automatic times, sec is the GORM default. Postgres and
+// MySQL store microseconds at most
+var timePrecisions = map[string]int32{"sec": 0, "milli": 3, "micro": 6}
+
+// unixPrecisions are the units of the unix times GORM fills integer columns
+// with
+var unixPrecisions = map[string]bool{"sec": true, "milli": true, "nano": true}
+
+// createdAtField is the field GORM fills with the time of the creation by
+// convention
+const createdAtField = "CreatedAt"
+
+// parseTimestamps sets the automatic times of the timestamps of the message
+// on their fields and validates the automatic times of all the fields
+func (p *OrmPlugin) parseTimestamps(message *protogen.Message) {
+	ormable := p.getOrmableMessage(message)
+	if timestamps := getMessageOptions(message).GetTimestamps(); timestamps != nil {
+		created := p.setAutoTime(ormable, timestamps.GetCreatedAt(), timestamps.CreatedAt != nil, timestamps.GetPrecision(),
+			func(tag *gorm.GormTag) **string { return &tag.AutoCreateTime })
+		updated := p.setAutoTime(ormable, timestamps.GetUpdatedAt(), timestamps.UpdatedAt != nil, timestamps.GetPrecision(),
+			func(tag *gorm.GormTag) **string { return &tag.AutoUpdateTime })
+		if !created && !updated {
+			p.Fail(ormable.OriginName, "has timestamps but no created_at or updated_at field")
+		}
+	}
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		tag := field.GetTag()
+		if tag == nil {
+			continue
+		}
+		if tag.AutoCreateTime != nil {
+			p.autoTimeColumn(ormable, fieldName, field, tag.AutoCreateTime)
+		}
+		if tag.AutoUpdateTime != nil {
+			p.autoTimeColumn(ormable, fieldName, field, tag.AutoUpdateTime)
+		}
+	}
+}
+
+// setAutoTime sets the precision of an automatic time of the named field,
+// unless the field sets it itself, a field named by default may be missing
+func (p *OrmPlugin) setAutoTime(ormable *OrmableType, name string, named bool, precision string, autoTime func(*gorm.GormTag) **string) bool {
+	if name == "" {
+		return false
+	}
+	_, field := p.findColumnField(ormable, name)
+	if field == nil {
+		if named {
+			p.Fail("timestamp", name, "of", ormable.OriginName, "is not a field of the message")
+		}
+		return false
+	}
+	if field.Tag == nil {
+		field.Tag = &gorm.GormTag{}
+	}
+	if setting := autoTime(field.Tag); *setting == nil {
+		value := precision
+		*setting = &value
+	}
+	return true
+}
+
+// autoTimeColumn validates the precision of an automatic time of the field,
+// integer columns store it in the GORM setting and time columns only in their
+// precision, GORM would fill them with unix times in the other precisions
+func (p *OrmPlugin) autoTimeColumn(ormable *OrmableType, fieldName string, field *Field, precision *string) {
+	value := strings.ToLower(*precision)
+	switch {
+	case isTimeField(field):
+		digits, ok := timePrecisions[value]
+		if !ok && value != "" {
+			p.Fail("automatic time of", fieldName, "of", ormable.OriginName, "has precision", *precision, "expected sec, milli or micro")
+		}
+		if value != "" && field.Tag.Precision == nil {
+			field.Tag.Precision = &digits
+		}
+		value = ""
+	case isIntegerField(field):
+		if !unixPrecisions[value] && value != "" {
+			p.Fail("automatic time of", fieldName, "of", ormable.OriginName, "has precision", *precision, "expected sec, milli or nano")
+		}
+		if value != "sec" && value != "" && !strings.HasSuffix(field.Type, "64") {
+			p.Fail("automatic time of", fieldName, "of", ormable.OriginName, "is in", value, "and needs a 64 bit integer")
+		}
+	default:
+		p.Fail("automatic time of", fieldName, "of", ormable.OriginName, "must be a Timestamp or an integer")
+	}
+	if value == "sec" {
+		value = ""
+	}
+	*precision = value
+}
+
+// createTimeFields are the fields filled with the time of the creation, by
+// their settings or the GORM convention
+func (p *OrmPlugin) createTimeFields(ormable *OrmableType) []string {
+	var res []string
+	for _, fieldName := range p.getSortedFieldNames(ormable.Fields) {
+		field := ormable.Fields[fieldName]
+		if (field.GetTag() != nil && field.Tag.AutoCreateTime != nil) || (fieldName == createdAtField && (isTimeField(field) || isIntegerField(field))) {
+			res = append(res, fieldName)
+		}
+	}
+	return res
+}
+
+func isTimeField(field *Field) bool {
+	ident := field.F.GoIdent
+	return ident.GoImportPath == identTime.GoImportPath && strings.TrimPrefix(ident.GoName, "*") == identTime.GoName
+}
+
+func isIntegerField(field *Field) bool {
+	switch strings.TrimPrefix(field.Type, "*") {
+	case "int", "int32", "int64", "uint", "uint32", "uint64":
+		return true
+	}
+	return false
+}
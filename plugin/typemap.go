@@ -0,0 +1,80 @@
+package plugin
+
+import (
+	"strings"
+
+	gorm "github.com/kirinse/protoc-gen-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// typeMapping returns the user-defined mapping of the message type of the
+// field, declared in the options of the file of the field
+func (p *OrmPlugin) typeMapping(field *protogen.Field) (*gorm.TypeMapping, bool) {
+	if field.Desc.Message() == nil {
+		return nil, false
+	}
+	name := string(field.Desc.Message().FullName())
+	for _, mapping := range getFileOptions(field.Desc.ParentFile()).GetTypeMapping() {
+		if mapping.GetMessage() == name {
+			return mapping, true
+		}
+	}
+	return nil, false
+}
+
+// typeMappingIdent is the ident of a Go type or function of a mapping, names
+// without an import path live in the package of the mapping, or in the
+// package of the generated code
+func (p *OrmPlugin) typeMappingIdent(mapping *gorm.TypeMapping, name string) protogen.GoIdent {
+	return p.userGoIdent(name, mapping.GetPackage())
+}
+
+// userGoIdent is the ident of a Go type or function named in the options,
+// either qualified like github.com/acme/geo.Point or living in goImportPath,
+// which defaults to the package of the generated code
+func (p *OrmPlugin) userGoIdent(name, goImportPath string) protogen.GoIdent {
+	isPtr := strings.HasPrefix(name, "*")
+	name = strings.TrimPrefix(name, "*")
+	ident := protogen.GoIdent{GoName: name, GoImportPath: protogen.GoImportPath(goImportPath)}
+	if ident.GoImportPath == "" {
+		ident.GoImportPath = p.currentPackage
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		ident = protogen.GoIdent{GoName: name[i+1:], GoImportPath: protogen.GoImportPath(name[:i])}
+	}
+	if isPtr {
+		return ptrIdent(ident)
+	}
+	return ident
+}
+
+// generateCustomConversion outputs the call of the to_orm_func or to_pb_func
+// of the field, it returns false when the field uses the default conversion
+func (p *OrmPlugin) generateCustomConversion(field *protogen.Field, toORM bool) bool {
+	opts := getFieldOptions(field)
+	convert := opts.GetToPbFunc()
+	if toORM {
+		convert = opts.GetToOrmFunc()
+	}
+	if convert == "" {
+		return false
+	}
+	fieldName := fieldName(field)
+	p.P(`if to.`, fieldName, `, err = `, p.userGoIdent(convert, ""), `(m.`, fieldName, `); err != nil {`)
+	p.P(`return to, err`)
+	p.P(`}`)
+	return true
+}
+
+// generateTypeMappingConversion outputs the call of the user function
+// converting a field of a mapped type to/from orm
+func (p *OrmPlugin) generateTypeMappingConversion(field *protogen.Field, mapping *gorm.TypeMapping, toORM bool) {
+	fieldName := fieldName(field)
+	convert := mapping.GetToPb()
+	if toORM {
+		convert = mapping.GetToOrm()
+	}
+	p.P(`if to.`, fieldName, `, err = `, p.typeMappingIdent(mapping, convert), `(m.`, fieldName, `); err != nil {`)
+	p.P(`return to, err`)
+	p.P(`}`)
+}
@@ -7,8 +7,23 @@ import (
 	gorm "github.com/kirinse/protoc-gen-gorm/options"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gorm.io/gorm/schema"
 )
 
+// naming is the GORM naming strategy the default table, column and join table
+// names are derived with
+var naming = schema.NamingStrategy{}
+
+// fileNaming is the naming strategy of the tables of a file, with its table
+// prefix and singular table settings
+func fileNaming(fileOpts *gorm.GormFileOptions) schema.NamingStrategy {
+	res := naming
+	res.TablePrefix = fileOpts.GetTablePrefix()
+	res.SingularTable = fileOpts.GetSingularTable()
+	return res
+}
+
 func ormIdent(ident protogen.GoIdent) protogen.GoIdent {
 	ident.GoName += "ORM"
 	return ident
@@ -45,6 +60,15 @@ func (p *OrmPlugin) fieldToPQArrayIdent(field *protogen.Field) (i protogen.GoIde
 	}
 }
 
+// enumToPQArrayIdent tells which PQ array a repeated enum is stored in, enum
+// values are stored as integers, or as names when stored by name
+func (p *OrmPlugin) enumToPQArrayIdent(field *protogen.Field) (protogen.GoIdent, string) {
+	if p.enumAsString(field) {
+		return identpqStringArray, "text[]"
+	}
+	return identpqInt32Array, "integer[]"
+}
+
 func (p *OrmPlugin) qualifiedGoIdent(ident protogen.GoIdent) string {
 	isPointer := strings.Contains(ident.GoName, "*")
 	isList := strings.Contains(ident.GoName, "[]")
@@ -104,6 +128,48 @@ func getFieldOptions(field *protogen.Field) *gorm.GormFieldOptions {
 	return opts
 }
 
+func getOneofOptions(oneof *protogen.Oneof) *gorm.GormOneofOptions {
+	if oneof.Desc.Options() == nil {
+		return nil
+	}
+	v := proto.GetExtension(oneof.Desc.Options(), gorm.E_Oneof)
+	opts, ok := v.(*gorm.GormOneofOptions)
+	if !ok {
+		return nil
+	}
+	return opts
+}
+
+// retrieves the GormFileOptions from the file declaring a descriptor
+func getFileOptions(file protoreflect.FileDescriptor) *gorm.GormFileOptions {
+	if file.Options() == nil {
+		return nil
+	}
+	v := proto.GetExtension(file.Options(), gorm.E_FileOpts)
+	opts, ok := v.(*gorm.GormFileOptions)
+	if !ok {
+		return nil
+	}
+	return opts
+}
+
+// setUUIDLibrary picks the UUID implementation of the code generated for the
+// file, the file option wins over the uuid plugin parameter
+func (p *OrmPlugin) setUUIDLibrary(file *protogen.File) {
+	name, source := p.UUIDLibrary, "uuid parameter"
+	if option := getFileOptions(file.Desc).GetUuidLibrary(); option != "" {
+		name, source = option, file.Desc.Path()
+	}
+	if name == "" {
+		name = defaultUUIDLibrary
+	}
+	library, ok := uuidLibraries[name]
+	if !ok {
+		p.Fail("unknown uuid library", name, "in", source, "expected one of google, gofrs or satori")
+	}
+	p.uuid = library
+}
+
 func getServiceOptions(service *protogen.Service) *gorm.AutoServerOptions {
 	if service.Desc.Options() == nil {
 		return nil
@@ -129,6 +195,9 @@ func getMethodOptions(method *protogen.Method) *gorm.MethodOptions {
 }
 
 func (p *OrmPlugin) isSpecialType(field *protogen.Field) bool {
+	if _, ok := p.typeMapping(field); ok {
+		return true
+	}
 	var ident protogen.GoIdent
 	if field.Message != nil {
 		ident = field.Message.GoIdent
@@ -152,7 +221,75 @@ func (p *OrmPlugin) isSpecialType(field *protogen.Field) bool {
 		protoTypeResource,
 		protoTypeInet,
 		protoTimeOnly,
-		protoTypeTimestamp:
+		protoTypeTimestamp,
+		protoTypeDuration,
+		protoTypeDate,
+		protoTypeDecimal,
+		protoTypeStruct,
+		protoTypeValue,
+		protoTypeListValue:
+		return true
+	}
+	return false
+}
+
+// isDateMessage tells if the message is a calendar date, stored as types.Date
+func isDateMessage(message protoreflect.MessageDescriptor) bool {
+	switch message.FullName() {
+	case "gorm.types.Date", "google.type.Date":
+		return true
+	}
+	return false
+}
+
+// isDecimalMessage tells if the message is an exact decimal number, stored as
+// types.Numeric
+func isDecimalMessage(message protoreflect.MessageDescriptor) bool {
+	switch message.FullName() {
+	case "gorm.types.Decimal", "google.type.Decimal":
+		return true
+	}
+	return false
+}
+
+// numericColumnType is the numeric(p,s) column type of a decimal, precision
+// is the total number of digits and scale the number of fractional digits
+func numericColumnType(tag *gorm.GormTag) string {
+	if tag.Scale == nil {
+		return fmt.Sprintf("numeric(%d)", tag.GetPrecision())
+	}
+	return fmt.Sprintf("numeric(%d,%d)", tag.GetPrecision(), tag.GetScale())
+}
+
+// isOptionalScalar tells if the scalar field tracks presence, like a proto3
+// optional field, so that it is stored in a nullable column
+func isOptionalScalar(field *protogen.Field) bool {
+	return field.Desc.Message() == nil && field.Desc.HasPresence() && !isOneofField(field)
+}
+
+// isBytesValue tells if the field is a google.protobuf.BytesValue, stored as
+// a nullable []byte
+func isBytesValue(field *protogen.Field) bool {
+	return field.Desc.Message() != nil && field.Desc.Message().FullName() == "google.protobuf.BytesValue"
+}
+
+// nullableScalar turns the ORM type of a scalar field into a pointer, so an
+// unset field is stored as NULL, []byte is nullable already
+func nullableScalar(field *protogen.Field) {
+	if field.Desc.Message() != nil || field.GoIdent.GoName == "[]byte" {
+		return
+	}
+	field.GoIdent.GoName = "*" + field.GoIdent.GoName
+}
+
+// isStructMessage tells if the message is one of the free-form JSON well known
+// types, stored as datatypes.JSON
+func isStructMessage(message protoreflect.MessageDescriptor) bool {
+	if message == nil {
+		return false
+	}
+	switch message.FullName() {
+	case "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue":
 		return true
 	}
 	return false
@@ -188,8 +325,25 @@ func fieldIdent(field *protogen.Field) protogen.GoIdent {
 	return field.GoIdent
 }
 
+// messageType is the Go name of the message, nested messages are prefixed
+// with the name of their parent like Outer_Inner
 func (p *OrmPlugin) messageType(message *protogen.Message) string {
-	return string(message.Desc.Name())
+	return message.GoIdent.GoName
+}
+
+// allMessages lists the messages declared in a file, nested ones included,
+// map entries are left out
+func allMessages(messages []*protogen.Message) []*protogen.Message {
+	var res []*protogen.Message
+	for _, msg := range messages {
+		// We don't want to bother with the MapEntry stuff
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		res = append(res, msg)
+		res = append(res, allMessages(msg.Messages)...)
+	}
+	return res
 }
 
 func messageName(message *protogen.Message) string {
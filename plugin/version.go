@@ -0,0 +1,95 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// versionKinds are the kinds of the fields a version is stored in
+var versionKinds = map[protoreflect.Kind]struct{}{
+	protoreflect.Int32Kind:    {},
+	protoreflect.Sint32Kind:   {},
+	protoreflect.Sfixed32Kind: {},
+	protoreflect.Uint32Kind:   {},
+	protoreflect.Fixed32Kind:  {},
+	protoreflect.Int64Kind:    {},
+	protoreflect.Sint64Kind:   {},
+	protoreflect.Sfixed64Kind: {},
+	protoreflect.Uint64Kind:   {},
+	protoreflect.Fixed64Kind:  {},
+}
+
+// parseVersion stores the Go name of the version field of the message, the
+// version is a plain integer of the message so clients read it and send it
+// back with their updates
+func (p *OrmPlugin) parseVersion(message *protogen.Message) {
+	name := getMessageOptions(message).GetVersionField()
+	if name == "" {
+		return
+	}
+	ormable := p.getOrmableMessage(message)
+	fieldName, field := p.findColumnField(ormable, name)
+	if field == nil || field.F.Desc == nil {
+		p.Fail("version field", name, "of", ormable.OriginName, "is not a field of the message")
+	}
+	if _, ok := versionKinds[field.F.Desc.Kind()]; !ok || field.F.Desc.IsList() || field.F.Desc.HasPresence() {
+		p.Fail("version field", name, "of", ormable.OriginName, "must be a plain integer")
+	}
+	if !p.hasPrimaryKey(ormable) {
+		p.Fail("versioned", ormable.OriginName, "has no primary key")
+	}
+	if field.GetTag().GetPrimaryKey() {
+		p.Fail("version field", name, "of", ormable.OriginName, "is a primary key")
+	}
+	ormable.Version = fieldName
+}
+
+// isVersioned tells if the updates of the message are guarded by a version
+func (p *OrmPlugin) isVersioned(message *protogen.Message) bool {
+	return p.getOrmableMessage(message).Version != ""
+}
+
+// generateVersionCheck fails the strict update of a row found with another
+// version than the one of the update, before its children are touched
+func (p *OrmPlugin) generateVersionCheck(ormable *OrmableType) {
+	p.P(`if count != 0 && lockedRow.`, ormable.Version, ` != ormObj.`, ormable.Version, ` {`)
+	p.P(`return nil, `, p.versionConflict(ormable, "ormObj."+ormable.Version))
+	p.P(`}`)
+}
+
+// generateVersionedSave creates the missing rows and updates the others only
+// if they still have the version of the update, bumping it
+func (p *OrmPlugin) generateVersionedSave(ormable *OrmableType) {
+	field := ormable.Fields[ormable.Version]
+	p.P(`if count == 0 {`)
+	p.P(`if err = db`, p.omitAssociations(ormable, false), `.Save(&ormObj).Error; err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`} else {`)
+	p.P(`version := ormObj.`, ormable.Version)
+	p.P(`ormObj.`, ormable.Version, `++`)
+	p.P(`res := db`, p.omitAssociations(ormable, false), `.Model(&ormObj).Select("*").Where("`, columnName(ormable.Version, field), ` = ?", version).Updates(&ormObj)`)
+	p.P(`if res.Error != nil {`)
+	p.P(`return nil, res.Error`)
+	p.P(`}`)
+	p.P(`if res.RowsAffected == 0 {`)
+	p.P(`return nil, `, p.versionConflict(ormable, "version"))
+	p.P(`}`)
+	p.P(`}`)
+}
+
+// versionConflict is the error of an update of the given version
+func (p *OrmPlugin) versionConflict(ormable *OrmableType, version string) string {
+	return `&` + p.qualifiedGoIdent(identVersionConflictError) + `{Type: "` + ormable.OriginName + `", Version: int64(` + version + `)}`
+}
+
+// generateVersionConflictStatus maps the version conflicts of the updates to
+// a FAILED_PRECONDITION status
+func (p *OrmPlugin) generateVersionConflictStatus(typeName string) {
+	if !p.isVersioned(p.getOrmable(typeName).Message) {
+		return
+	}
+	p.P(`if `, identIsVersionConflictFn, `(err) {`)
+	p.P(`err = `, identStatusErrorFn, `(`, identCodesFailedPrecondition, `, err.Error())`)
+	p.P(`}`)
+}
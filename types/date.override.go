@@ -34,9 +34,12 @@ func (date Date) GobEncode() ([]byte, error) {
 }
 
 func (date *Date) GobDecode(b []byte) error {
-	y, m, d := int(date.Year), time.Month(date.Month), int(date.Day)
-	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
-	return (&t).GobDecode(b)
+	var t time.Time
+	if err := t.GobDecode(b); err != nil {
+		return err
+	}
+	date.setTime(t)
+	return nil
 }
 
 func (date Date) MarshalJSON() ([]byte, error) {
@@ -46,7 +49,17 @@ func (date Date) MarshalJSON() ([]byte, error) {
 }
 
 func (date *Date) UnmarshalJSON(b []byte) error {
-	y, m, d := int(date.Year), time.Month(date.Month), int(date.Day)
-	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
-	return (&t).UnmarshalJSON(b)
+	var t time.Time
+	if err := t.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	date.setTime(t)
+	return nil
+}
+
+// setTime populates the date with the calendar day of t
+func (date *Date) setTime(t time.Time) {
+	date.Year = int32(t.Year())
+	date.Month = int32(t.Month())
+	date.Day = int32(t.Day())
 }
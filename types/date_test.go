@@ -0,0 +1,44 @@
+package types
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+func TestDateJSON(t *testing.T) {
+	in := &Date{Year: 2021, Month: 7, Day: 14}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if string(b) != `"2021-07-14T00:00:00Z"` {
+		t.Errorf("Expected value: %q, got %s", "2021-07-14T00:00:00Z", b)
+	}
+	out := &Date{}
+	if err := json.Unmarshal(b, out); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if out.Year != in.Year || out.Month != in.Month || out.Day != in.Day {
+		t.Errorf("Expected value: %v, got %v", in, out)
+	}
+	if err := json.Unmarshal([]byte(`"not a date"`), out); err == nil {
+		t.Errorf("Expected error but didn't get any")
+	}
+}
+
+func TestDateGob(t *testing.T) {
+	in := &Date{Year: 1999, Month: 12, Day: 31}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	out := &Date{}
+	if err := gob.NewDecoder(&buf).Decode(out); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if out.Year != in.Year || out.Month != in.Month || out.Day != in.Day {
+		t.Errorf("Expected value: %v, got %v", in, out)
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import "context"
+
+// DeletedFilter chooses the rows the default list handlers of soft deleted
+// types return
+type DeletedFilter int
+
+const (
+	// ExcludeDeleted lists the rows which are not deleted, the default
+	ExcludeDeleted DeletedFilter = iota
+	// IncludeDeleted lists the deleted rows along with the others
+	IncludeDeleted
+	// OnlyDeleted lists the deleted rows only
+	OnlyDeleted
+)
+
+type deletedFilterKey struct{}
+
+// WithDeletedFilter returns a copy of ctx the default list handlers read the
+// filter of soft deleted rows from
+func WithDeletedFilter(ctx context.Context, filter DeletedFilter) context.Context {
+	return context.WithValue(ctx, deletedFilterKey{}, filter)
+}
+
+// DeletedFilterFromContext returns the filter of soft deleted rows set on
+// ctx, ExcludeDeleted when there is none
+func DeletedFilterFromContext(ctx context.Context) DeletedFilter {
+	filter, _ := ctx.Value(deletedFilterKey{}).(DeletedFilter)
+	return filter
+}
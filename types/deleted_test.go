@@ -0,0 +1,18 @@
+package types
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDeletedFilterFromContext(t *testing.T) {
+	ctx := context.Background()
+	if filter := DeletedFilterFromContext(ctx); filter != ExcludeDeleted {
+		t.Errorf("Expected ExcludeDeleted by default, got %d", filter)
+	}
+	for _, filter := range []DeletedFilter{ExcludeDeleted, IncludeDeleted, OnlyDeleted} {
+		if got := DeletedFilterFromContext(WithDeletedFilter(ctx, filter)); got != filter {
+			t.Errorf("Expected filter %d, got %d", filter, got)
+		}
+	}
+}
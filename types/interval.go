@@ -0,0 +1,103 @@
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is a special scannable type for a duration stored in a Postgres
+// interval column
+type Interval time.Duration
+
+const (
+	day   = 24 * time.Hour
+	month = 30 * day
+	// postgres counts 365.25 days per year when extracting the epoch of an interval
+	year = 365*day + 6*time.Hour
+)
+
+var intervalUnits = map[string]time.Duration{
+	"year": year, "years": year,
+	"mon": month, "mons": month,
+	"day": day, "days": day,
+	// as written by Value
+	"microseconds": time.Microsecond,
+}
+
+// Value implements the Value part of the sql scannable interface
+func (i Interval) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d microseconds", time.Duration(i).Microseconds()), nil
+}
+
+// Scan implements the scan part of the sql scannable interface
+func (i *Interval) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	var strdat string
+	bytes, ok := value.([]byte)
+	if !ok {
+		if strdat, ok = value.(string); !ok {
+			return errors.New("Could not cast value in Interval.Scan as []byte or string")
+		}
+	} else {
+		strdat = string(bytes)
+	}
+	interval, err := ParseInterval(strdat)
+	if err != nil {
+		return err
+	}
+	*i = interval
+	return nil
+}
+
+// ParseInterval will return the Interval represented in the postgres output
+// style, e.g. "1 year 2 mons -3 days 04:05:06.789"
+func ParseInterval(s string) (Interval, error) {
+	var d time.Duration
+	fields := strings.Fields(s)
+	for i := 0; i < len(fields); i++ {
+		if strings.Contains(fields[i], ":") {
+			t, err := parseIntervalTime(fields[i])
+			if err != nil {
+				return 0, err
+			}
+			d += t
+			continue
+		}
+		if i+1 == len(fields) {
+			return 0, fmt.Errorf("missing unit in interval %q", s)
+		}
+		n, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q: %v", s, err)
+		}
+		unit, ok := intervalUnits[fields[i+1]]
+		if !ok {
+			return 0, fmt.Errorf("unknown unit %q in interval %q", fields[i+1], s)
+		}
+		d += time.Duration(n) * unit
+		i++
+	}
+	return Interval(d), nil
+}
+
+// parseIntervalTime parses the [-]hh:mm:ss[.ffffff] part of an interval
+func parseIntervalTime(s string) (time.Duration, error) {
+	parts := strings.Split(strings.TrimLeft(s, "+-"), ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return 0, fmt.Errorf("invalid interval time %q", s)
+	}
+	d, err := time.ParseDuration(parts[0] + "h" + parts[1] + "m" + parts[2] + "s")
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval time %q", s)
+	}
+	if strings.HasPrefix(s, "-") {
+		d = -d
+	}
+	return d, nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseInterval(t *testing.T) {
+	cases := []struct {
+		str         string
+		value       time.Duration
+		expectError bool
+	}{
+		{"00:00:00", 0, false},
+		{"01:02:03", time.Hour + 2*time.Minute + 3*time.Second, false},
+		{"-00:00:01.5", -1500 * time.Millisecond, false},
+		{"00:00:00.000001", time.Microsecond, false},
+		{"3 days", 3 * 24 * time.Hour, false},
+		{"1 day -02:00:00", 22 * time.Hour, false},
+		{"1 mon 1 day", 31 * 24 * time.Hour, false},
+		{"1 year", 365*24*time.Hour + 6*time.Hour, false},
+		{"1500 microseconds", 1500 * time.Microsecond, false},
+		{"1", 0, true},
+		{"1 week", 0, true},
+		{"01:02", 0, true},
+		{"aa:00:00", 0, true},
+	}
+
+	for _, v := range cases {
+		t.Run(fmt.Sprintf("Check interval %s", v.str), func(t *testing.T) {
+			interval, err := ParseInterval(v.str)
+			if err != nil && !v.expectError {
+				t.Errorf("Got unexpected error: %s", err)
+			}
+			if v.expectError && err == nil {
+				t.Errorf("Expected error but didn't get any")
+			}
+			if time.Duration(interval) != v.value {
+				t.Errorf("Expected value: %s, got %s", v.value, time.Duration(interval))
+			}
+		})
+	}
+}
+
+func TestIntervalValueScan(t *testing.T) {
+	in := Interval(26*time.Hour + 1500*time.Microsecond)
+	value, err := in.Value()
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if value != "93600001500 microseconds" {
+		t.Errorf("Expected value: 93600001500 microseconds, got %v", value)
+	}
+	var out Interval
+	if err := out.Scan([]byte("1 day 02:00:00.0015")); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if out != in {
+		t.Errorf("Expected value: %s, got %s", time.Duration(in), time.Duration(out))
+	}
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Numeric is a special scannable type for an exact decimal number, it is
+// stored in a numeric column and never goes through floating point
+type Numeric struct {
+	// the number is unscaled * 10^-scale
+	unscaled big.Int
+	scale    int32
+}
+
+var bigTen = big.NewInt(10)
+
+// ParseNumeric will return the Numeric represented in the input string, in
+// plain or exponent notation, e.g. "-12.50" or "1.25e3"
+func ParseNumeric(s string) (*Numeric, error) {
+	mantissa, exponent := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		if exponent, err = strconv.ParseInt(s[i+1:], 10, 16); err != nil {
+			return nil, fmt.Errorf("invalid numeric %q", s)
+		}
+		mantissa = s[:i]
+	}
+	sign := ""
+	if len(mantissa) > 0 && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid numeric %q", s)
+	}
+	n := &Numeric{}
+	n.unscaled.SetString(sign+digits, 10)
+	scale := int64(len(fracPart)) - exponent
+	if scale < 0 {
+		n.unscaled.Mul(&n.unscaled, new(big.Int).Exp(bigTen, big.NewInt(-scale), nil))
+		scale = 0
+	}
+	n.scale = int32(scale)
+	return n, nil
+}
+
+// String returns the number in plain notation, keeping its scale
+func (n *Numeric) String() string {
+	digits := new(big.Int).Abs(&n.unscaled).String()
+	if n.scale > 0 {
+		if pad := int(n.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(n.scale)] + "." + digits[len(digits)-int(n.scale):]
+	}
+	if n.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Rat returns the exact value of the number
+func (n *Numeric) Rat() *big.Rat {
+	denom := new(big.Int).Exp(bigTen, big.NewInt(int64(n.scale)), nil)
+	return new(big.Rat).SetFrac(&n.unscaled, denom)
+}
+
+// Value implements the Value part of the sql scannable interface
+func (n Numeric) Value() (driver.Value, error) {
+	return n.String(), nil
+}
+
+// Scan implements the scan part of the sql scannable interface
+func (n *Numeric) Scan(value interface{}) error {
+	var strdat string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		strdat = string(v)
+	case string:
+		strdat = v
+	case int64:
+		strdat = strconv.FormatInt(v, 10)
+	default:
+		return errors.New("Could not cast value in Numeric.Scan as []byte, string or int64")
+	}
+	numeric, err := ParseNumeric(strdat)
+	if err != nil {
+		return err
+	}
+	*n = *numeric
+	return nil
+}
+
+// GormDataType gorm common data type
+func (Numeric) GormDataType() string {
+	return "numeric"
+}
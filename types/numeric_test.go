@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestParseNumeric(t *testing.T) {
+	cases := []struct {
+		str         string
+		value       string
+		expectError bool
+	}{
+		{"0", "0", false},
+		{"12.50", "12.50", false},
+		{"-0.001", "-0.001", false},
+		{"+7", "7", false},
+		{".5", "0.5", false},
+		{"5.", "5", false},
+		{"1.25e3", "1250", false},
+		{"125E-4", "0.0125", false},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", false},
+		{"", "", true},
+		{"-", "", true},
+		{".", "", true},
+		{"1.2.3", "", true},
+		{"1e", "", true},
+		{"1e99999", "", true},
+		{"0x10", "", true},
+		{"NaN", "", true},
+	}
+
+	for _, v := range cases {
+		t.Run(fmt.Sprintf("Check numeric %s", v.str), func(t *testing.T) {
+			numeric, err := ParseNumeric(v.str)
+			if err != nil && !v.expectError {
+				t.Errorf("Got unexpected error: %s", err)
+			}
+			if v.expectError && err == nil {
+				t.Errorf("Expected error but didn't get any")
+			}
+			if err == nil && numeric.String() != v.value {
+				t.Errorf("Expected value: %s, got %s", v.value, numeric)
+			}
+		})
+	}
+}
+
+func TestNumericValueScan(t *testing.T) {
+	in, err := ParseNumeric("-1234.5600")
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	value, err := in.Value()
+	if err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if value != "-1234.5600" {
+		t.Errorf("Expected value: -1234.5600, got %v", value)
+	}
+	if in.Rat().Cmp(big.NewRat(-123456, 100)) != 0 {
+		t.Errorf("Expected value: -1234.56, got %s", in.Rat())
+	}
+	out := &Numeric{}
+	if err := out.Scan([]byte("-1234.5600")); err != nil {
+		t.Fatalf("Got unexpected error: %s", err)
+	}
+	if out.String() != in.String() {
+		t.Errorf("Expected value: %s, got %s", in, out)
+	}
+	if err := out.Scan(int64(42)); err != nil || out.String() != "42" {
+		t.Errorf("Expected value: 42, got %s (%v)", out, err)
+	}
+	if err := out.Scan(1.5); err == nil {
+		t.Errorf("Expected error but didn't get any")
+	}
+}